@@ -0,0 +1,59 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	liquidityante "shogun/x/liquidity/ante"
+	liquiditymodulekeeper "shogun/x/liquidity/keeper"
+)
+
+// HandlerOptions are the options required for constructing the app's
+// AnteHandler.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	StakingKeeper   liquidityante.StakingKeeper
+	LiquidityKeeper liquiditymodulekeeper.Keeper
+}
+
+// NewAnteHandler returns an AnteHandler like the auth module's default one,
+// except that fees can also be paid in non-native coins which have a liquid
+// pair against the native coin.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	if options.StakingKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "staking keeper is required for ante builder")
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		liquidityante.NewDeductFeeDecorator(
+			options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.StakingKeeper,
+			options.LiquidityKeeper, liquidityante.DefaultFeeHaircut, options.TxFeeChecker),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
@@ -0,0 +1,16 @@
+package params
+
+// Default simulation operation weights for messages and gov proposals.
+const (
+	DefaultWeightMsgCreatePair       int = 10
+	DefaultWeightMsgCreatePool       int = 15
+	DefaultWeightMsgCreateRangedPool int = 20
+	DefaultWeightMsgDeposit          int = 20
+	DefaultWeightMsgWithdraw         int = 20
+	DefaultWeightMsgLimitOrder       int = 80
+	DefaultWeightMsgMarketOrder      int = 60
+	DefaultWeightMsgMMOrder          int = 20
+	DefaultWeightMsgCancelOrder      int = 20
+	DefaultWeightMsgCancelAllOrders  int = 20
+	DefaultWeightMsgCancelMMOrder    int = 20
+)
@@ -0,0 +1,156 @@
+package app
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	liquiditymodulekeeper "shogun/x/liquidity/keeper"
+	liquiditymoduletypes "shogun/x/liquidity/types"
+)
+
+// BatchReplay is the outcome of replaying a batch of a pair.
+type BatchReplay struct {
+	// Pair is the pair after the batch has been executed.
+	Pair liquiditymoduletypes.Pair
+	// LastPrice is the last price of the pair before the batch.
+	LastPrice *math.LegacyDec
+	// OrderBook is the order book of the pair before the batch.
+	// It is nil if the pair didn't have the last price.
+	OrderBook *liquiditymoduletypes.OrderBookPairResponse
+	// Matched tells whether any order has been matched in the batch.
+	Matched bool
+	// MatchPrice is the price at which orders have been matched.
+	MatchPrice *math.LegacyDec
+	OrderFills []OrderFill
+	PoolFills  []PoolFill
+}
+
+// OrderFill is the result of an order matched in a replayed batch.
+type OrderFill struct {
+	// Order is the order after the batch has been executed.
+	Order        liquiditymoduletypes.Order
+	PaidCoin     sdk.Coin
+	ReceivedCoin sdk.Coin
+}
+
+// PoolFill is the change of a pool's reserve in a replayed batch.
+type PoolFill struct {
+	PoolId uint64
+	// BaseCoinDiff and QuoteCoinDiff are the changes of the reserve
+	// balances, which are negative if the pool paid the coin.
+	BaseCoinDiff, QuoteCoinDiff math.Int
+}
+
+// ReplayBatch loads the app state into an in-memory app and executes
+// matching on the pair as it would be done in the next batch, within a block
+// with the given header.
+// Only the auth, bank and liquidity module states are loaded, since matching
+// doesn't depend on the rest.
+// numTicks is the number of ticks of the order book in the result.
+func ReplayBatch(appState GenesisState, pairId uint64, header tmproto.Header, numTicks uint32) (replay BatchReplay, err error) {
+	app := New(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome,
+		0, MakeEncodingConfig(), simapp.EmptyAppOptions{})
+	ctx := app.BaseApp.NewUncachedContext(false, header)
+
+	defer func() {
+		// Module's InitGenesis and matching panic on an invalid state.
+		if r := recover(); r != nil {
+			err = fmt.Errorf("replay batch: %v", r)
+		}
+	}()
+
+	for _, moduleName := range []string{
+		authtypes.ModuleName,
+		banktypes.ModuleName,
+		liquiditymoduletypes.ModuleName,
+	} {
+		bz, ok := appState[moduleName]
+		if !ok {
+			return BatchReplay{}, fmt.Errorf("app state of %s module not found", moduleName)
+		}
+		app.mm.Modules[moduleName].InitGenesis(ctx, app.appCodec, bz)
+	}
+
+	k := app.LiquidityKeeper
+	pair, found := k.GetPair(ctx, pairId)
+	if !found {
+		return BatchReplay{}, fmt.Errorf("pair %d not found", pairId)
+	}
+	replay.LastPrice = pair.LastPrice
+
+	if pair.LastPrice != nil {
+		resp, err := liquiditymodulekeeper.Querier{Keeper: k}.OrderBooks(sdk.WrapSDKContext(ctx), &liquiditymoduletypes.QueryOrderBooksRequest{
+			PairIds:  []uint64{pairId},
+			NumTicks: numTicks,
+		})
+		if err != nil {
+			return BatchReplay{}, err
+		}
+		replay.OrderBook = &resp.Pairs[0]
+	}
+
+	ordersBefore := map[uint64]liquiditymoduletypes.Order{}
+	_ = k.IterateOrdersByPair(ctx, pairId, func(order liquiditymoduletypes.Order) (stop bool, err error) {
+		ordersBefore[order.Id] = order
+		return false, nil
+	})
+	reserveBalances := func(pool liquiditymoduletypes.Pool) sdk.Coins {
+		return app.BankKeeper.SpendableCoins(ctx, pool.GetReserveAddress())
+	}
+	poolBalancesBefore := map[uint64]sdk.Coins{}
+	_ = k.IteratePoolsByPair(ctx, pairId, func(pool liquiditymoduletypes.Pool) (stop bool, err error) {
+		poolBalancesBefore[pool.Id] = reserveBalances(pool)
+		return false, nil
+	})
+
+	if err := k.ExecuteMatching(ctx, pair); err != nil {
+		return BatchReplay{}, err
+	}
+
+	replay.Pair, _ = k.GetPair(ctx, pairId)
+	_ = k.IterateOrdersByPair(ctx, pairId, func(order liquiditymoduletypes.Order) (stop bool, err error) {
+		before, ok := ordersBefore[order.Id]
+		if !ok {
+			return false, nil
+		}
+		paid := before.RemainingOfferCoin.Sub(order.RemainingOfferCoin)
+		received := order.ReceivedCoin.Sub(before.ReceivedCoin)
+		if paid.IsPositive() || received.IsPositive() {
+			replay.OrderFills = append(replay.OrderFills, OrderFill{
+				Order:        order,
+				PaidCoin:     paid,
+				ReceivedCoin: received,
+			})
+		}
+		return false, nil
+	})
+	_ = k.IteratePoolsByPair(ctx, pairId, func(pool liquiditymoduletypes.Pool) (stop bool, err error) {
+		before, after := poolBalancesBefore[pool.Id], reserveBalances(pool)
+		fill := PoolFill{
+			PoolId:        pool.Id,
+			BaseCoinDiff:  after.AmountOf(pair.BaseCoinDenom).Sub(before.AmountOf(pair.BaseCoinDenom)),
+			QuoteCoinDiff: after.AmountOf(pair.QuoteCoinDenom).Sub(before.AmountOf(pair.QuoteCoinDenom)),
+		}
+		if !fill.BaseCoinDiff.IsZero() || !fill.QuoteCoinDiff.IsZero() {
+			replay.PoolFills = append(replay.PoolFills, fill)
+		}
+		return false, nil
+	})
+
+	replay.Matched = len(replay.OrderFills) > 0 || len(replay.PoolFills) > 0
+	if replay.Matched {
+		// The last price of the pair is updated to the match price.
+		replay.MatchPrice = replay.Pair.LastPrice
+	}
+
+	return replay, nil
+}
@@ -0,0 +1,118 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"shogun/app"
+	utils "shogun/types"
+	"shogun/x/liquidity/amm"
+	liquiditytypes "shogun/x/liquidity/types"
+)
+
+func TestReplayBatch(t *testing.T) {
+	a := app.Setup(false)
+	hdr := tmproto.Header{Height: 1, Time: utils.ParseTime("2022-01-01T00:00:00Z")}
+	a.BeginBlock(abci.RequestBeginBlock{Header: hdr})
+	ctx := a.BaseApp.NewContext(false, hdr)
+	k := a.LiquidityKeeper
+
+	creator, orderer := utils.TestAddress(0), utils.TestAddress(1)
+
+	require.NoError(t, app.FundAccount(a.BankKeeper, ctx, creator, k.GetPairCreationFee(ctx)))
+	pair, err := k.CreatePair(ctx, liquiditytypes.NewMsgCreatePair(creator, "denom1", "denom2"))
+	require.NoError(t, err)
+	depositCoins := utils.ParseCoins("1000000denom1,1000000denom2")
+	require.NoError(t, app.FundAccount(a.BankKeeper, ctx, creator, depositCoins.Add(k.GetPoolCreationFee(ctx)...)))
+	pool, err := k.CreatePool(ctx, liquiditytypes.NewMsgCreatePool(creator, pair.Id, depositCoins))
+	require.NoError(t, err)
+
+	price, amt := utils.ParseDec("1.05"), math.NewInt(10000)
+	offerCoin := sdk.NewCoin("denom2", amm.OfferCoinAmount(amm.Buy, price, amt))
+	require.NoError(t, app.FundAccount(a.BankKeeper, ctx, orderer, sdk.NewCoins(offerCoin)))
+	order, err := k.LimitOrder(ctx, liquiditytypes.NewMsgLimitOrder(
+		orderer, pair.Id, liquiditytypes.OrderDirectionBuy, offerCoin, "denom1", price, amt, time.Hour))
+	require.NoError(t, err)
+
+	// Commit without running EndBlock, so the order is left unmatched.
+	a.Commit()
+
+	exported, err := a.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	var appState app.GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+
+	replay, err := app.ReplayBatch(appState, pair.Id, tmproto.Header{
+		Height: exported.Height,
+		Time:   hdr.Time.Add(5 * time.Second),
+	}, 10)
+	require.NoError(t, err)
+
+	require.Nil(t, replay.LastPrice)
+	require.Nil(t, replay.OrderBook)
+	require.True(t, replay.Matched)
+	require.NotNil(t, replay.MatchPrice)
+	require.True(math.LegacyDecEq(t, *replay.Pair.LastPrice, *replay.MatchPrice))
+	require.Equal(t, pair.CurrentBatchId+1, replay.Pair.CurrentBatchId)
+
+	require.Len(t, replay.OrderFills, 1)
+	fill := replay.OrderFills[0]
+	require.Equal(t, order.Id, fill.Order.Id)
+	require.Equal(t, liquiditytypes.OrderStatusCompleted, fill.Order.Status)
+	require.True(t, fill.ReceivedCoin.IsEqual(sdk.NewInt64Coin("denom1", 10000)))
+	require.True(t, fill.PaidCoin.IsPositive())
+
+	require.Len(t, replay.PoolFills, 1)
+	poolFill := replay.PoolFills[0]
+	require.Equal(t, pool.Id, poolFill.PoolId)
+	require.True(t, poolFill.BaseCoinDiff.IsNegative())
+	require.True(t, poolFill.QuoteCoinDiff.IsPositive())
+
+	// Replaying doesn't touch the original app's state.
+	ctx = a.BaseApp.NewContext(true, tmproto.Header{})
+	order, found := a.LiquidityKeeper.GetOrder(ctx, pair.Id, order.Id)
+	require.True(t, found)
+	require.Equal(t, liquiditytypes.OrderStatusNotExecuted, order.Status)
+
+	_, err = app.ReplayBatch(appState, 2, tmproto.Header{Height: exported.Height, Time: hdr.Time}, 10)
+	require.EqualError(t, err, "pair 2 not found")
+}
+
+func TestReplayBatch_OrderBook(t *testing.T) {
+	a := app.Setup(false)
+	hdr := tmproto.Header{Height: 1, Time: utils.ParseTime("2022-01-01T00:00:00Z")}
+	a.BeginBlock(abci.RequestBeginBlock{Header: hdr})
+	ctx := a.BaseApp.NewContext(false, hdr)
+	k := a.LiquidityKeeper
+
+	creator := utils.TestAddress(0)
+	fees := k.GetPairCreationFee(ctx)
+	require.NoError(t, a.BankKeeper.MintCoins(ctx, liquiditytypes.ModuleName, fees))
+	require.NoError(t, a.BankKeeper.SendCoinsFromModuleToAccount(ctx, liquiditytypes.ModuleName, creator, fees))
+	pair, err := k.CreatePair(ctx, liquiditytypes.NewMsgCreatePair(creator, "denom1", "denom2"))
+	require.NoError(t, err)
+	lastPrice := utils.ParseDec("1.0")
+	pair.LastPrice = &lastPrice
+	k.SetPair(ctx, pair)
+	a.Commit()
+
+	exported, err := a.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	var appState app.GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+
+	replay, err := app.ReplayBatch(appState, pair.Id, tmproto.Header{Height: exported.Height, Time: hdr.Time}, 10)
+	require.NoError(t, err)
+	require.False(t, replay.Matched)
+	require.Nil(t, replay.MatchPrice)
+	require.NotNil(t, replay.OrderBook)
+	require.Equal(t, pair.Id, replay.OrderBook.PairId)
+	require.True(math.LegacyDecEq(t, lastPrice, *replay.LastPrice))
+}
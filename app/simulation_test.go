@@ -5,13 +5,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"shogun/app"
 )
@@ -20,6 +24,12 @@ func init() {
 	simapp.GetSimulatorFlags()
 }
 
+// fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
+// an IAVLStore for faster simulation speed.
+func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
 var defaultConsensusParams = &abci.ConsensusParams{
 	Block: &abci.BlockParams{
 		MaxBytes: 200000,
@@ -91,3 +101,65 @@ func BenchmarkSimulation(b *testing.B) {
 		simapp.PrintStats(db)
 	}
 }
+
+// setupSimulation returns the simulation config set up from the simulator
+// flags. Unless the simulation is explicitly enabled with -Enabled, a short
+// simulation checking the invariants every block is configured instead, so
+// the simulation always runs as part of the regular tests.
+func setupSimulation(t *testing.T) (simulationtypes.Config, dbm.DB, log.Logger, uint) {
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	require.NoError(t, err, "simulation setup failed")
+	if !skip {
+		t.Cleanup(func() {
+			require.NoError(t, db.Close())
+			require.NoError(t, os.RemoveAll(dir))
+		})
+		return config, db, logger, simapp.FlagPeriodValue
+	}
+
+	config = simapp.NewConfigFromFlags()
+	config.ChainID = helpers.SimAppChainID
+	config.NumBlocks = 25
+	config.BlockSize = 50
+	config.Commit = true
+	return config, dbm.NewMemDB(), log.NewNopLogger(), 1
+}
+
+func TestFullAppSimulation(t *testing.T) {
+	config, db, logger, invCheckPeriod := setupSimulation(t)
+
+	app := app.New(
+		logger,
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		invCheckPeriod,
+		app.MakeEncodingConfig(),
+		simapp.EmptyAppOptions{},
+		fauxMerkleModeOpt,
+	)
+
+	// run randomized simulation
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		simapp.AppStateFn(app.AppCodec(), app.SimulationManager()),
+		simulationtypes.RandomAccounts,
+		simapp.SimulationOperations(app, app.AppCodec(), config),
+		app.ModuleAccountAddrs(),
+		config,
+		app.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	err := simapp.CheckExportSimulation(app, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simapp.PrintStats(db)
+	}
+}
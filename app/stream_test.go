@@ -0,0 +1,134 @@
+//go:build norace
+// +build norace
+
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"shogun/testutil/network"
+	utils "shogun/types"
+	liquiditytestutil "shogun/x/liquidity/client/testutil"
+	liquiditytypes "shogun/x/liquidity/types"
+)
+
+// StreamTestSuite runs the order book stream against a single node network,
+// which serves the Stream service on its gRPC server and feeds it from the
+// node's commit path.
+type StreamTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+	val     *network.Validator
+	client  liquiditytypes.StreamClient
+
+	denom1, denom2 string
+}
+
+func TestStreamTestSuite(t *testing.T) {
+	suite.Run(t, new(StreamTestSuite))
+}
+
+func (s *StreamTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping test in unit-tests mode.")
+	}
+
+	cfg := network.DefaultConfig()
+	s.network = network.New(s.T(), cfg)
+	s.val = s.network.Validators[0]
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	grpcCodec := codec.NewProtoCodec(s.val.ClientCtx.InterfaceRegistry).GRPCCodec()
+	conn, err := grpc.Dial(
+		s.val.AppConfig.GRPC.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)))
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { conn.Close() })
+	s.client = liquiditytypes.NewStreamClient(conn)
+
+	s.denom1, s.denom2 = s.val.Moniker+"token", cfg.BondDenom
+	_, err = liquiditytestutil.MsgCreatePair(s.val.ClientCtx, s.val.Address.String(), s.denom1, s.denom2)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+}
+
+func (s *StreamTestSuite) subscribe(req *liquiditytypes.SubscribeOrderBooksRequest) liquiditytypes.Stream_SubscribeOrderBooksClient {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	s.T().Cleanup(cancel)
+	stream, err := s.client.SubscribeOrderBooks(ctx, req)
+	s.Require().NoError(err)
+	return stream
+}
+
+func (s *StreamTestSuite) limitOrder(dir liquiditytypes.OrderDirection, price math.LegacyDec, amt math.Int) {
+	offerCoin := utils.ParseCoin(amt.String() + s.denom1)
+	demandCoinDenom := s.denom2
+	if dir == liquiditytypes.OrderDirectionBuy {
+		offerCoin = utils.ParseCoin(price.MulInt(amt).Ceil().TruncateInt().String() + s.denom2)
+		demandCoinDenom = s.denom1
+	}
+	_, err := liquiditytestutil.MsgLimitOrder(
+		s.val.ClientCtx, s.val.Address.String(), 1, dir, offerCoin, demandCoinDenom, price, amt, time.Hour)
+	s.Require().NoError(err)
+}
+
+func (s *StreamTestSuite) TestSubscribeOrderBooks() {
+	stream := s.subscribe(&liquiditytypes.SubscribeOrderBooksRequest{PairIds: []uint64{1}, NumTicks: 10})
+
+	// The first update is a snapshot, sent after the next block.
+	update, err := stream.Recv()
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, update.PairId)
+	s.Require().True(update.Snapshot)
+
+	// Orders matched in a batch are sent as trades, and the order left
+	// shows up in the order book.
+	s.limitOrder(liquiditytypes.OrderDirectionBuy, utils.ParseDec("1.0"), math.NewInt(1_000_000))
+	s.limitOrder(liquiditytypes.OrderDirectionSell, utils.ParseDec("1.0"), math.NewInt(1_000_000))
+	s.limitOrder(liquiditytypes.OrderDirectionBuy, utils.ParseDec("0.99"), math.NewInt(1000))
+	for {
+		update, err = stream.Recv()
+		s.Require().NoError(err)
+		if len(update.UserOrderTrades) > 0 {
+			break
+		}
+	}
+	s.Require().True(update.Batch.Matched)
+	s.Require().Len(update.UserOrderTrades, 2)
+	s.Require().Empty(update.PoolOrderTrades)
+	for len(update.OrderBook.Buys) == 0 {
+		update, err = stream.Recv()
+		s.Require().NoError(err)
+	}
+	s.Require().True(update.OrderBook.Buys[0].Price.Equal(utils.ParseDec("0.99")))
+	s.Require().True(update.OrderBook.Buys[0].UserOrderAmount.Equal(math.NewInt(1000)))
+}
+
+func (s *StreamTestSuite) TestSubscribeOrderBooks_PairNotFound() {
+	stream := s.subscribe(&liquiditytypes.SubscribeOrderBooksRequest{PairIds: []uint64{100}})
+	_, err := stream.Recv()
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *StreamTestSuite) TestSubscribeOrderBooks_Limits() {
+	stream := s.subscribe(&liquiditytypes.SubscribeOrderBooksRequest{PairIds: []uint64{1, 2, 3, 4, 5, 6}})
+	_, err := stream.Recv()
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	stream = s.subscribe(&liquiditytypes.SubscribeOrderBooksRequest{PairIds: []uint64{1}, NumTicks: 21})
+	_, err = stream.Recv()
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"encoding/json"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// DefaultConsensusParams defines the default Tendermint consensus params used in
+// App testing.
+var DefaultConsensusParams = &abci.ConsensusParams{
+	Block: &abci.BlockParams{
+		MaxBytes: 200000,
+		MaxGas:   2000000,
+	},
+	Evidence: &tmproto.EvidenceParams{
+		MaxAgeNumBlocks: 302400,
+		MaxAgeDuration:  504 * time.Hour, // 3 weeks is the max duration
+		MaxBytes:        10000,
+	},
+	Validator: &tmproto.ValidatorParams{
+		PubKeyTypes: []string{
+			tmtypes.ABCIPubKeyTypeEd25519,
+		},
+	},
+}
+
+func setup(withGenesis bool, invCheckPeriod uint) (*App, GenesisState) {
+	db := dbm.NewMemDB()
+	encCdc := MakeEncodingConfig()
+	app := New(
+		log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome,
+		invCheckPeriod, encCdc, simapp.EmptyAppOptions{})
+	if withGenesis {
+		return app, NewDefaultGenesisState(encCdc.Marshaler)
+	}
+	return app, GenesisState{}
+}
+
+// Setup initializes a new App with a single genesis validator.
+// A Nop logger is set in App.
+func Setup(isCheckTx bool) *App {
+	app, genState := setup(!isCheckTx, 5)
+	if !isCheckTx {
+		genState = genesisStateWithSingleValidator(app, genState)
+		stateBytes, err := json.MarshalIndent(genState, "", " ")
+		if err != nil {
+			panic(err)
+		}
+
+		// Initialize the chain
+		app.InitChain(
+			abci.RequestInitChain{
+				Validators:      []abci.ValidatorUpdate{},
+				ConsensusParams: DefaultConsensusParams,
+				AppStateBytes:   stateBytes,
+			},
+		)
+	}
+
+	return app
+}
+
+// genesisStateWithSingleValidator adds a single bonded validator, which is
+// required by the staking module, to the genesis state.
+func genesisStateWithSingleValidator(app *App, genState GenesisState) GenesisState {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	if err != nil {
+		panic(err)
+	}
+	val := tmtypes.NewValidator(pubKey, 1)
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), []authtypes.GenesisAccount{acc})
+	genState[authtypes.ModuleName] = app.AppCodec().MustMarshalJSON(authGenesis)
+
+	pk, err := cryptocodec.FromTmPubKeyInterface(val.PubKey)
+	if err != nil {
+		panic(err)
+	}
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		panic(err)
+	}
+	bondAmt := sdk.DefaultPowerReduction
+	validator := stakingtypes.Validator{
+		OperatorAddress:   sdk.ValAddress(val.Address).String(),
+		ConsensusPubkey:   pkAny,
+		Jailed:            false,
+		Status:            stakingtypes.Bonded,
+		Tokens:            bondAmt,
+		DelegatorShares:   sdk.OneDec(),
+		Description:       stakingtypes.Description{},
+		UnbondingHeight:   int64(0),
+		UnbondingTime:     time.Unix(0, 0).UTC(),
+		Commission:        stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation: sdk.ZeroInt(),
+	}
+	delegation := stakingtypes.NewDelegation(acc.GetAddress(), val.Address.Bytes(), sdk.OneDec())
+	stakingGenesis := stakingtypes.NewGenesisState(
+		stakingtypes.DefaultParams(), []stakingtypes.Validator{validator}, []stakingtypes.Delegation{delegation})
+	genState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(stakingGenesis)
+
+	bondedCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt))
+	balances := []banktypes.Balance{
+		{
+			Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+			Coins:   bondedCoins,
+		},
+	}
+	bankGenesis := banktypes.NewGenesisState(
+		banktypes.DefaultGenesisState().Params, balances, bondedCoins, []banktypes.Metadata{})
+	genState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(bankGenesis)
+
+	return genState
+}
+
+// FundAccount is a utility function that funds an account by minting and
+// sending the coins to the address. This should be used for testing purposes
+// only!
+func FundAccount(bankKeeper bankkeeper.Keeper, ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	if err := bankKeeper.MintCoins(ctx, minttypes.ModuleName, amounts); err != nil {
+		return err
+	}
+	return bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, amounts)
+}
+
+// TestBlockInterval is the interval between blocks run by RunBlock.
+const TestBlockInterval = 5 * time.Second
+
+// TestGenesisTime is the time from which blocks run by RunBlock start.
+var TestGenesisTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// RunBlock runs the next block of the app and commits it, calling f between
+// BeginBlock and EndBlock if f is not nil. The block time is
+// TestBlockInterval times the height after TestGenesisTime.
+// It returns the height of the block. This should be used for testing
+// purposes only!
+func RunBlock(app *App, f func(ctx sdk.Context)) int64 {
+	height := app.LastBlockHeight() + 1
+	hdr := tmproto.Header{
+		Height: height,
+		Time:   TestGenesisTime.Add(time.Duration(height) * TestBlockInterval),
+	}
+	app.BeginBlock(abci.RequestBeginBlock{Header: hdr})
+	if f != nil {
+		f(app.BaseApp.NewContext(false, hdr))
+	}
+	app.EndBlock(abci.RequestEndBlock{Height: hdr.Height})
+	app.Commit()
+	return height
+}
@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"shogun/app"
+	liquiditytypes "shogun/x/liquidity/types"
+)
+
+const (
+	flagReplayHeight    = "height"
+	flagReplayBlockTime = "block-time"
+	flagReplayNumTicks  = "num-ticks"
+)
+
+// liquidityCommand returns the sub-command for offline tools of the
+// liquidity module.
+func liquidityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "liquidity",
+		Short:                      "Offline tools for the liquidity module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ReplayCmd(),
+	)
+
+	return cmd
+}
+
+// ReplayCmd returns a command that replays the next batch of a pair offline.
+func ReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [genesis-file|home-dir] [pair-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Replay the next batch of a pair offline",
+		Long: `Replay the next batch of a pair offline, without running a node.
+The state is loaded either from an exported genesis file (the output of the export command)
+or from the state snapshot stored in a node's home directory, into an in-memory app.
+Then matching is executed on the pair and the resulting fills, match price and
+the order book before matching are printed out.
+
+When replaying from a genesis file, the batch is executed at the initial height and
+the genesis time of it by default.
+When replaying from a home directory, the latest state is loaded by default and
+the block time must be given.
+
+Example:
+$ shogund liquidity replay exported-genesis.json 1
+$ shogund liquidity replay exported-genesis.json 1 --block-time=2022-01-01T00:00:00Z
+$ shogund liquidity replay ~/.shogun 1 --height=100000 --block-time=2022-01-01T00:00:00Z
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pairId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+			height, err := cmd.Flags().GetInt64(flagReplayHeight)
+			if err != nil {
+				return err
+			}
+			blockTimeStr, err := cmd.Flags().GetString(flagReplayBlockTime)
+			if err != nil {
+				return err
+			}
+			numTicks, err := cmd.Flags().GetUint32(flagReplayNumTicks)
+			if err != nil {
+				return err
+			}
+
+			var blockTime time.Time
+			if blockTimeStr != "" {
+				blockTime, err = time.Parse(time.RFC3339Nano, blockTimeStr)
+				if err != nil {
+					return fmt.Errorf("parse block time: %w", err)
+				}
+			}
+
+			info, err := os.Stat(args[0])
+			if err != nil {
+				return err
+			}
+			var (
+				appState app.GenesisState
+				header   tmproto.Header
+			)
+			if info.IsDir() {
+				if blockTime.IsZero() {
+					return fmt.Errorf("--%s must be given when replaying from a home directory", flagReplayBlockTime)
+				}
+				appState, header, err = loadAppStateFromHome(cmd, args[0], height)
+			} else {
+				appState, header, err = loadAppStateFromGenesis(args[0])
+				if height != 0 {
+					header.Height = height
+				}
+			}
+			if err != nil {
+				return err
+			}
+			if !blockTime.IsZero() {
+				header.Time = blockTime
+			}
+
+			replay, err := app.ReplayBatch(appState, pairId, header, numTicks)
+			if err != nil {
+				return err
+			}
+
+			printBatchReplay(cmd.OutOrStdout(), header, replay)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagReplayHeight, 0, "Height of the block in which the batch is executed; when replaying from a home directory, the state at the height is loaded")
+	cmd.Flags().String(flagReplayBlockTime, "", "Time of the block in which the batch is executed, in RFC3339 format")
+	cmd.Flags().Uint32(flagReplayNumTicks, 20, "Number of ticks of the order book to print")
+
+	return cmd
+}
+
+// loadAppStateFromGenesis loads the app state from an exported genesis file.
+func loadAppStateFromGenesis(path string) (app.GenesisState, tmproto.Header, error) {
+	genDoc, err := tmtypes.GenesisDocFromFile(path)
+	if err != nil {
+		return nil, tmproto.Header{}, err
+	}
+	var appState app.GenesisState
+	if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+		return nil, tmproto.Header{}, fmt.Errorf("unmarshal app state: %w", err)
+	}
+	header := tmproto.Header{
+		ChainID: genDoc.ChainID,
+		Height:  genDoc.InitialHeight,
+		Time:    genDoc.GenesisTime,
+	}
+	return appState, header, nil
+}
+
+// loadAppStateFromHome loads the app state at the given height from the
+// application database in a node's home directory.
+// If height is 0, the latest state is loaded.
+func loadAppStateFromHome(cmd *cobra.Command, home string, height int64) (app.GenesisState, tmproto.Header, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(home, "data"))
+	if err != nil {
+		return nil, tmproto.Header{}, err
+	}
+	defer db.Close()
+
+	a := app.New(
+		log.NewNopLogger(), db, nil, height == 0, map[int64]bool{}, home,
+		0, app.MakeEncodingConfig(), simapp.EmptyAppOptions{})
+	if height != 0 {
+		// The state at the height is the one before the block at the height
+		// is executed.
+		if err := a.LoadHeight(height - 1); err != nil {
+			return nil, tmproto.Header{}, err
+		}
+	}
+
+	exported, err := a.ExportAppStateAndValidators(false, nil)
+	if err != nil {
+		return nil, tmproto.Header{}, err
+	}
+	var appState app.GenesisState
+	if err := json.Unmarshal(exported.AppState, &appState); err != nil {
+		return nil, tmproto.Header{}, fmt.Errorf("unmarshal app state: %w", err)
+	}
+	return appState, tmproto.Header{Height: exported.Height}, nil
+}
+
+// printBatchReplay prints out the result of a replayed batch.
+func printBatchReplay(w io.Writer, header tmproto.Header, replay app.BatchReplay) {
+	pair := replay.Pair
+	fmt.Fprintf(w, "pair %d (%s/%s), batch %d, height %d, time %s\n",
+		pair.Id, pair.BaseCoinDenom, pair.QuoteCoinDenom, pair.CurrentBatchId-1,
+		header.Height, header.Time.Format(time.RFC3339Nano))
+
+	if replay.OrderBook != nil && len(replay.OrderBook.OrderBooks) > 0 {
+		// The order book with the smallest price unit is printed.
+		fmt.Fprintf(w, "order book before matching (price unit %s):\n", replay.OrderBook.OrderBooks[0].PriceUnit)
+		liquiditytypes.PrintOrderBookResponse(w, replay.OrderBook.OrderBooks[0], *replay.LastPrice)
+	} else {
+		fmt.Fprintln(w, "order book before matching: unavailable, the pair doesn't have the last price")
+	}
+
+	if !replay.Matched {
+		fmt.Fprintln(w, "matched: false")
+		return
+	}
+	fmt.Fprintln(w, "matched: true")
+	fmt.Fprintf(w, "match price: %s\n", *replay.MatchPrice)
+
+	fmt.Fprintf(w, "order fills (%d):\n", len(replay.OrderFills))
+	for _, fill := range replay.OrderFills {
+		order := fill.Order
+		fmt.Fprintf(w, "  order %d %s %s %s by %s: paid %s, received %s, open amount %s, status %s\n",
+			order.Id, order.Direction, order.Amount, order.Price, order.Orderer,
+			fill.PaidCoin, fill.ReceivedCoin, order.OpenAmount, order.Status)
+	}
+
+	fmt.Fprintf(w, "pool fills (%d):\n", len(replay.PoolFills))
+	for _, fill := range replay.PoolFills {
+		fmt.Fprintf(w, "  pool %d: %s%s, %s%s\n",
+			fill.PoolId, fill.BaseCoinDiff, pair.BaseCoinDenom, fill.QuoteCoinDiff, pair.QuoteCoinDenom)
+	}
+}
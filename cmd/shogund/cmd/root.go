@@ -139,6 +139,7 @@ func initRootCmd(
 		rpc.StatusCommand(),
 		queryCommand(),
 		txCommand(),
+		liquidityCommand(),
 		keys.Commands(app.DefaultNodeHome),
 	)
 }
@@ -323,8 +324,23 @@ func (a appCreator) appExport(
 func initAppConfig() (string, interface{}) {
 	// The following code snippet is just for reference.
 
+	// LiquidityConfig defines app config options of the liquidity module.
+	type LiquidityConfig struct {
+		// EmitLegacyEvents tells whether to emit the deprecated events with
+		// string attributes along with the typed events.
+		EmitLegacyEvents bool `mapstructure:"emit-legacy-events"`
+		// StateExportFile is the file to export writes to the liquidity store
+		// as JSON lines.
+		StateExportFile string `mapstructure:"state-export-file"`
+		// StateExportHaltOnError tells whether to halt the node if exporting
+		// writes to the liquidity store fails.
+		StateExportHaltOnError bool `mapstructure:"state-export-halt-on-error"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config
+
+		Liquidity LiquidityConfig `mapstructure:"liquidity"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -346,8 +362,30 @@ func initAppConfig() (string, interface{}) {
 
 	customAppConfig := CustomAppConfig{
 		Config: *srvCfg,
+		Liquidity: LiquidityConfig{
+			EmitLegacyEvents: true,
+		},
 	}
-	customAppTemplate := serverconfig.DefaultConfigTemplate
+	customAppTemplate := serverconfig.DefaultConfigTemplate + `
+###############################################################################
+###                         Liquidity Configuration                         ###
+###############################################################################
+
+[liquidity]
+
+# Emit the deprecated liquidity module events with string attributes along with
+# the typed events. The legacy events will be removed in the next release.
+emit-legacy-events = {{ .Liquidity.EmitLegacyEvents }}
+
+# File to export writes to the liquidity store, including deletions, as JSON
+# lines. A relative path is relative to the node's home directory.
+# Exporting is disabled if it is empty.
+state-export-file = "{{ .Liquidity.StateExportFile }}"
+
+# Halt the node if exporting writes to the liquidity store fails, so that no
+# write is missed. Otherwise, the error is only logged.
+state-export-halt-on-error = {{ .Liquidity.StateExportHaltOnError }}
+`
 
 	return customAppTemplate, customAppConfig
 }
@@ -137,51 +137,6 @@ func RandomDec(r *rand.Rand, min, max math.LegacyDec) math.LegacyDec {
 	return min.Add(math.LegacyNewDecFromBigIntWithPrec(new(big.Int).Rand(r, max.Sub(min).BigInt()), sdk.Precision))
 }
 
-// // GenAndDeliverTx generates a transactions and delivers it.
-// func GenAndDeliverTx(txCtx simulation.OperationInput, fees sdk.Coins, gas uint64) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-// 	account := txCtx.AccountKeeper.GetAccount(txCtx.Context, txCtx.SimAccount.Address)
-// 	tx, err := helpers.GenTx(
-// 		txCtx.TxGen,
-// 		[]sdk.Msg{txCtx.Msg},
-// 		fees,
-// 		gas,
-// 		txCtx.Context.ChainID(),
-// 		[]uint64{account.GetAccountNumber()},
-// 		[]uint64{account.GetSequence()},
-// 		txCtx.SimAccount.PrivKey,
-// 	)
-
-// 	if err != nil {
-// 		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "unable to generate mock tx"), nil, err
-// 	}
-
-// 	_, _, err = txCtx.App.Deliver(txCtx.TxGen.TxEncoder(), tx)
-// 	if err != nil {
-// 		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "unable to deliver tx"), nil, err
-// 	}
-
-// 	return simtypes.NewOperationMsg(txCtx.Msg, true, "", txCtx.Cdc), nil, nil
-
-// }
-
-// GenAndDeliverTxWithFees generates a transaction with given fee and delivers it.
-// func GenAndDeliverTxWithFees(txCtx simulation.OperationInput, gas uint64, fees sdk.Coins) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-// 	account := txCtx.AccountKeeper.GetAccount(txCtx.Context, txCtx.SimAccount.Address)
-// 	spendable := txCtx.Bankkeeper.SpendableCoins(txCtx.Context, account.GetAddress())
-
-// 	var err error
-
-// 	_, hasNeg := spendable.SafeSub(txCtx.CoinsSpentInMsg...)
-// 	if hasNeg {
-// 		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "message doesn't leave room for fees"), nil, err
-// 	}
-
-// 	if err != nil {
-// 		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "unable to generate fees"), nil, err
-// 	}
-// 	return GenAndDeliverTx(txCtx, fees, gas)
-// }
-
 // ShuffleSimAccounts returns randomly shuffled simulation accounts.
 func ShuffleSimAccounts(r *rand.Rand, accs []simtypes.Account) []simtypes.Account {
 	accs2 := make([]simtypes.Account, len(accs))
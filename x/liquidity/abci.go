@@ -21,6 +21,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	params := k.GetParams(ctx)
 	if ctx.BlockHeight()%int64(params.BatchSize) == 0 {
+		k.ConvertFees(ctx)
 		k.ExecuteRequests(ctx)
 	}
 }
@@ -0,0 +1,242 @@
+package amm_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"shogun/x/liquidity/amm"
+)
+
+// parseFuzzPrice parses a price generated by the fuzzer, returning false
+// if the price is not within the valid pool price range.
+func parseFuzzPrice(s string) (math.LegacyDec, bool) {
+	price, err := math.LegacyNewDecFromStr(s)
+	if err != nil || price.LT(amm.MinPoolPrice) || price.GT(amm.MaxPoolPrice) {
+		return math.LegacyDec{}, false
+	}
+	return price, true
+}
+
+// parseFuzzRangedPoolParams parses the min and max price of a ranged pool
+// generated by the fuzzer, returning false if they are invalid.
+func parseFuzzRangedPoolParams(minPriceStr, maxPriceStr string) (minPrice, maxPrice math.LegacyDec, ok bool) {
+	minPrice, ok1 := parseFuzzPrice(minPriceStr)
+	maxPrice, ok2 := parseFuzzPrice(maxPriceStr)
+	if !ok1 || !ok2 || amm.ValidateRangedPoolParams(minPrice, maxPrice, minPrice) != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, false
+	}
+	return minPrice, maxPrice, true
+}
+
+func FuzzDeriveTranslation(f *testing.F) {
+	for _, tc := range rangedPoolDepositTestCases {
+		f.Add(tc.rx.Int64(), tc.ry.Int64(), tc.minPrice.String(), tc.maxPrice.String())
+	}
+	for _, tc := range rangedPoolWithdrawTestCases {
+		f.Add(tc.rx.Int64(), tc.ry.Int64(), tc.minPrice.String(), tc.maxPrice.String())
+	}
+
+	f.Fuzz(func(t *testing.T, rx, ry int64, minPriceStr, maxPriceStr string) {
+		if rx < 0 || ry < 0 || (rx == 0 && ry == 0) {
+			t.Skip()
+		}
+		minPrice, maxPrice, ok := parseFuzzRangedPoolParams(minPriceStr, maxPriceStr)
+		if !ok {
+			t.Skip()
+		}
+
+		transX, transY := amm.DeriveTranslation(math.NewInt(rx), math.NewInt(ry), minPrice, maxPrice)
+		require.False(t, transX.IsNegative(), "negative transX: %s", transX)
+		require.False(t, transY.IsNegative(), "negative transY: %s", transY)
+	})
+}
+
+func FuzzRangedPoolBuyAmountTo(f *testing.F) {
+	for _, tc := range rangedPoolBuyAmountToTestCases {
+		rx, ry := tc.pool.Balances()
+		f.Add(rx.Int64(), ry.Int64(), tc.pool.MinPrice().String(), tc.pool.MaxPrice().String(), tc.price.String())
+	}
+
+	f.Fuzz(func(t *testing.T, rx, ry int64, minPriceStr, maxPriceStr, priceStr string) {
+		if rx < 0 || ry < 0 || (rx == 0 && ry == 0) {
+			t.Skip()
+		}
+		minPrice, maxPrice, ok := parseFuzzRangedPoolParams(minPriceStr, maxPriceStr)
+		if !ok {
+			t.Skip()
+		}
+		price, ok := parseFuzzPrice(priceStr)
+		if !ok {
+			t.Skip()
+		}
+
+		pool := amm.NewRangedPool(math.NewInt(rx), math.NewInt(ry), math.Int{}, minPrice, maxPrice)
+		amt := pool.BuyAmountTo(price)
+		require.False(t, amt.IsNegative(), "negative amount: %s", amt)
+		require.True(t, amt.LTE(amm.MaxCoinAmount), "amount exceeds max coin amount: %s", amt)
+		// The pool must not pay more than its x reserve for the amount.
+		if amt.LT(amm.MaxCoinAmount) {
+			paid := math.LegacyNewDecFromInt(amt).MulTruncate(price)
+			require.True(t, paid.LTE(math.LegacyNewDec(rx)), "pays %s, but reserve is %d", paid, rx)
+		}
+	})
+}
+
+func FuzzDeposit(f *testing.F) {
+	for _, tc := range basicPoolDepositTestCases {
+		f.Add(tc.rx, tc.ry, tc.ps, tc.x, tc.y)
+	}
+	for _, tc := range rangedPoolDepositTestCases {
+		f.Add(tc.rx.Int64(), tc.ry.Int64(), tc.ps.Int64(), tc.x.Int64(), tc.y.Int64())
+	}
+
+	f.Fuzz(func(t *testing.T, rx, ry, ps, x, y int64) {
+		if rx < 0 || ry < 0 || (rx == 0 && ry == 0) || ps <= 0 || x < 0 || y < 0 {
+			t.Skip()
+		}
+		rxInt, ryInt, psInt := math.NewInt(rx), math.NewInt(ry), math.NewInt(ps)
+		xInt, yInt := math.NewInt(x), math.NewInt(y)
+
+		ax, ay, pc := amm.Deposit(rxInt, ryInt, psInt, xInt, yInt)
+		require.False(t, ax.IsNegative(), "negative accepted x: %s", ax)
+		require.False(t, ay.IsNegative(), "negative accepted y: %s", ay)
+		require.False(t, pc.IsNegative(), "negative minted pool coin: %s", pc)
+		require.True(t, ax.LTE(xInt), "accepted x %s is greater than deposited %s", ax, xInt)
+		require.True(t, ay.LTE(yInt), "accepted y %s is greater than deposited %s", ay, yInt)
+
+		// Withdrawing the minted pool coin right away must not return more
+		// than what was accepted.
+		wx, wy := amm.Withdraw(rxInt.Add(ax), ryInt.Add(ay), psInt.Add(pc), pc, math.LegacyZeroDec())
+		require.True(t, wx.LTE(ax), "withdrawn x %s is greater than accepted %s", wx, ax)
+		require.True(t, wy.LTE(ay), "withdrawn y %s is greater than accepted %s", wy, ay)
+	})
+}
+
+func FuzzWithdraw(f *testing.F) {
+	for _, tc := range basicPoolWithdrawTestCases {
+		f.Add(tc.rx, tc.ry, tc.ps, tc.pc, tc.feeRate.String())
+	}
+	for _, tc := range rangedPoolWithdrawTestCases {
+		f.Add(tc.rx.Int64(), tc.ry.Int64(), tc.ps.Int64(), tc.pc.Int64(), math.LegacyZeroDec().String())
+	}
+
+	f.Fuzz(func(t *testing.T, rx, ry, ps, pc int64, feeRateStr string) {
+		if rx < 0 || ry < 0 || ps <= 0 || pc <= 0 || pc > ps {
+			t.Skip()
+		}
+		feeRate, err := math.LegacyNewDecFromStr(feeRateStr)
+		if err != nil || feeRate.IsNegative() || feeRate.GT(math.LegacyOneDec()) {
+			t.Skip()
+		}
+		rxInt, ryInt, psInt, pcInt := math.NewInt(rx), math.NewInt(ry), math.NewInt(ps), math.NewInt(pc)
+
+		x, y := amm.Withdraw(rxInt, ryInt, psInt, pcInt, feeRate)
+		require.False(t, x.IsNegative(), "negative withdrawn x: %s", x)
+		require.False(t, y.IsNegative(), "negative withdrawn y: %s", y)
+		// The reserves never go negative.
+		require.True(t, x.LTE(rxInt), "withdrawn x %s is greater than reserve %s", x, rxInt)
+		require.True(t, y.LTE(ryInt), "withdrawn y %s is greater than reserve %s", y, ryInt)
+		// x / rx, y / ry <= pc / ps
+		require.True(t, x.Mul(psInt).LTE(pcInt.Mul(rxInt)))
+		require.True(t, y.Mul(psInt).LTE(pcInt.Mul(ryInt)))
+	})
+}
+
+func FuzzTickIndex(f *testing.F) {
+	for _, tc := range tickTestCases {
+		f.Add(tc.i, tc.prec)
+	}
+
+	f.Fuzz(func(t *testing.T, i, prec int) {
+		if prec < 1 || prec > 6 {
+			t.Skip()
+		}
+		if i < 0 || i > amm.TickToIndex(amm.HighestTick(prec), prec) {
+			t.Skip()
+		}
+
+		tick := amm.TickFromIndex(i, prec)
+		require.True(t, tick.IsPositive(), "non-positive tick: %s", tick)
+		require.Equal(t, i, amm.TickToIndex(tick, prec))
+		require.True(math.LegacyDecEq(t, tick, amm.PriceToDownTick(tick, prec)))
+	})
+}
+
+func FuzzFindMatchPrice(f *testing.F) {
+	for _, tc := range findMatchPriceTestCases {
+		// A missing side is seeded as an empty order at the price of 1.
+		buyPrice, sellPrice := "1", "1"
+		var buyAmt, sellAmt int64
+		if price, found := tc.ov.HighestBuyPrice(); found {
+			buyPrice, buyAmt = price.String(), tc.ov.BuyAmountOver(price, true).Int64()
+		}
+		if price, found := tc.ov.LowestSellPrice(); found {
+			sellPrice, sellAmt = price.String(), tc.ov.SellAmountUnder(price, true).Int64()
+		}
+		f.Add(buyPrice, buyAmt, sellPrice, sellAmt, int64(0), int64(0))
+		f.Add(buyPrice, buyAmt, sellPrice, sellAmt, int64(1000000), int64(1000000))
+	}
+
+	f.Fuzz(func(t *testing.T, buyPriceStr string, buyAmt int64, sellPriceStr string, sellAmt int64, rx, ry int64) {
+		tickPrec := int(defTickPrec)
+		buyPrice, ok1 := parseFuzzPrice(buyPriceStr)
+		sellPrice, ok2 := parseFuzzPrice(sellPriceStr)
+		if !ok1 || !ok2 || buyAmt < 0 || sellAmt < 0 || rx < 0 || ry < 0 {
+			t.Skip()
+		}
+		buyPrice = amm.PriceToDownTick(buyPrice, tickPrec)
+		sellPrice = amm.PriceToDownTick(sellPrice, tickPrec)
+		lowestPrice, highestPrice := math.LegacyMinDec(buyPrice, sellPrice), math.LegacyMaxDec(buyPrice, sellPrice)
+		// Limit the price range to keep the number of pool orders small.
+		if highestPrice.GT(lowestPrice.MulInt64(2)) {
+			t.Skip()
+		}
+
+		// makeOrderBook returns a fresh order book, since matching
+		// mutates the orders in it.
+		makeOrderBook := func() *amm.OrderBook {
+			var orders []amm.Order
+			if buyAmt > 0 {
+				orders = append(orders, newOrder(amm.Buy, buyPrice, math.NewInt(buyAmt)))
+			}
+			if sellAmt > 0 {
+				orders = append(orders, newOrder(amm.Sell, sellPrice, math.NewInt(sellAmt)))
+			}
+			if rx > 0 && ry > 0 {
+				pool := amm.NewBasicPool(math.NewInt(rx), math.NewInt(ry), math.Int{})
+				poolOrders := amm.PoolOrders(pool, amm.DefaultOrderer, lowestPrice, highestPrice, tickPrec)
+				// The pool's orders never spend more than its reserves.
+				x, y := math.ZeroInt(), math.ZeroInt()
+				for _, order := range poolOrders {
+					switch order.GetDirection() {
+					case amm.Buy:
+						x = x.Add(order.GetOfferCoinAmount())
+					case amm.Sell:
+						y = y.Add(order.GetOfferCoinAmount())
+					}
+				}
+				require.True(t, x.LTE(math.NewInt(rx)), "pool buy orders offer %s, but reserve is %d", x, rx)
+				require.True(t, y.LTE(math.NewInt(ry)), "pool sell orders offer %s, but reserve is %d", y, ry)
+				orders = append(orders, poolOrders...)
+			}
+			return amm.NewOrderBook(orders...)
+		}
+
+		matchPrice, found := amm.FindMatchPrice(makeOrderBook().MakeView(), tickPrec)
+		if found {
+			require.True(math.LegacyDecEq(t, matchPrice, amm.PriceToDownTick(matchPrice, tickPrec)))
+
+			quoteCoinDiff, matched := makeOrderBook().MatchAtSinglePrice(matchPrice)
+			if matched {
+				require.False(t, quoteCoinDiff.IsNegative(), "negative quote coin diff: %s", quoteCoinDiff)
+			}
+		}
+
+		_, quoteCoinDiff, matched := makeOrderBook().Match(buyPrice)
+		if matched {
+			require.False(t, quoteCoinDiff.IsNegative(), "negative quote coin diff: %s", quoteCoinDiff)
+		}
+	})
+}
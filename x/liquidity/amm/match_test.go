@@ -16,48 +16,50 @@ func newOrder(dir amm.OrderDirection, price math.LegacyDec, amt math.Int) amm.Or
 	return amm.DefaultOrderer.Order(dir, price, amt)
 }
 
+var findMatchPriceTestCases = []struct {
+	name       string
+	ov         amm.OrderView
+	found      bool
+	matchPrice math.LegacyDec
+}{
+	{
+		"happy case",
+		amm.NewOrderBook(
+			newOrder(amm.Buy, utils.ParseDec("1.1"), math.NewInt(10000)),
+			newOrder(amm.Sell, utils.ParseDec("0.9"), math.NewInt(10000)),
+		).MakeView(),
+		true,
+		utils.ParseDec("1.0"),
+	},
+	{
+		"buy order only",
+		amm.NewOrderBook(
+			newOrder(amm.Buy, utils.ParseDec("1.0"), math.NewInt(10000)),
+		).MakeView(),
+		false,
+		math.LegacyDec{},
+	},
+	{
+		"sell order only",
+		amm.NewOrderBook(
+			newOrder(amm.Sell, utils.ParseDec("1.0"), math.NewInt(10000)),
+		).MakeView(),
+		false,
+		math.LegacyDec{},
+	},
+	{
+		"highest buy price is lower than lowest sell price",
+		amm.NewOrderBook(
+			newOrder(amm.Buy, utils.ParseDec("0.9"), math.NewInt(10000)),
+			newOrder(amm.Sell, utils.ParseDec("1.1"), math.NewInt(10000)),
+		).MakeView(),
+		false,
+		math.LegacyDec{},
+	},
+}
+
 func TestFindMatchPrice(t *testing.T) {
-	for _, tc := range []struct {
-		name       string
-		ov         amm.OrderView
-		found      bool
-		matchPrice math.LegacyDec
-	}{
-		{
-			"happy case",
-			amm.NewOrderBook(
-				newOrder(amm.Buy, utils.ParseDec("1.1"), math.NewInt(10000)),
-				newOrder(amm.Sell, utils.ParseDec("0.9"), math.NewInt(10000)),
-			).MakeView(),
-			true,
-			utils.ParseDec("1.0"),
-		},
-		{
-			"buy order only",
-			amm.NewOrderBook(
-				newOrder(amm.Buy, utils.ParseDec("1.0"), math.NewInt(10000)),
-			).MakeView(),
-			false,
-			math.LegacyDec{},
-		},
-		{
-			"sell order only",
-			amm.NewOrderBook(
-				newOrder(amm.Sell, utils.ParseDec("1.0"), math.NewInt(10000)),
-			).MakeView(),
-			false,
-			math.LegacyDec{},
-		},
-		{
-			"highest buy price is lower than lowest sell price",
-			amm.NewOrderBook(
-				newOrder(amm.Buy, utils.ParseDec("0.9"), math.NewInt(10000)),
-				newOrder(amm.Sell, utils.ParseDec("1.1"), math.NewInt(10000)),
-			).MakeView(),
-			false,
-			math.LegacyDec{},
-		},
-	} {
+	for _, tc := range findMatchPriceTestCases {
 		t.Run(tc.name, func(t *testing.T) {
 			matchPrice, found := amm.FindMatchPrice(tc.ov, int(defTickPrec))
 			require.Equal(t, tc.found, found)
@@ -564,9 +564,11 @@ func DeriveTranslation(rx, ry math.Int, minPrice, maxPrice math.LegacyDec) (tran
 		// sqrtK = sqrt(K) = rx / (sqrt(P) - sqrt(M))
 		sqrtK = rxDec.Quo(sqrtP.Sub(sqrtM))
 	}
-	if !sqrtP.Equal(sqrtL) {
+	// 1/sqrt(P) - 1/sqrt(L) can be zero due to the limited precision even
+	// if P != L, which is treated as P == L.
+	if invDiff := inv(sqrtP).Sub(inv(sqrtL)); !invDiff.IsZero() {
 		// sqrtK2 = sqrt(K') = ry / (1/sqrt(P) - 1/sqrt(L))
-		sqrtK2 := ryDec.Quo(inv(sqrtP).Sub(inv(sqrtL)))
+		sqrtK2 := ryDec.Quo(invDiff)
 		if sqrtK.IsNil() { // P == M
 			sqrtK = sqrtK2
 		} else {
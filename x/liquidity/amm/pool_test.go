@@ -174,104 +174,106 @@ func TestBasicPool_IsDepleted(t *testing.T) {
 	}
 }
 
+var basicPoolDepositTestCases = []struct {
+	name   string
+	rx, ry int64 // reserve balance
+	ps     int64 // pool coin supply
+	x, y   int64 // depositing coin amount
+	ax, ay int64 // expected accepted coin amount
+	pc     int64 // expected minted pool coin amount
+}{
+	{
+		name: "ideal deposit",
+		rx:   2000,
+		ry:   100,
+		ps:   10000,
+		x:    200,
+		y:    10,
+		ax:   200,
+		ay:   10,
+		pc:   1000,
+	},
+	{
+		name: "unbalanced deposit",
+		rx:   2000,
+		ry:   100,
+		ps:   10000,
+		x:    100,
+		y:    2000,
+		ax:   100,
+		ay:   5,
+		pc:   500,
+	},
+	{
+		name: "decimal truncation",
+		rx:   222,
+		ry:   333,
+		ps:   333,
+		x:    100,
+		y:    100,
+		ax:   66,
+		ay:   99,
+		pc:   99,
+	},
+	{
+		name: "decimal truncation #2",
+		rx:   200,
+		ry:   300,
+		ps:   333,
+		x:    80,
+		y:    80,
+		ax:   53,
+		ay:   80,
+		pc:   88,
+	},
+	{
+		name: "zero minting amount",
+		ps:   100,
+		rx:   10000,
+		ry:   10000,
+		x:    99,
+		y:    99,
+		ax:   0,
+		ay:   0,
+		pc:   0,
+	},
+	{
+		name: "tiny minting amount",
+		rx:   10000,
+		ry:   10000,
+		ps:   100,
+		x:    100,
+		y:    100,
+		ax:   100,
+		ay:   100,
+		pc:   1,
+	},
+	{
+		name: "tiny minting amount #2",
+		rx:   10000,
+		ry:   10000,
+		ps:   100,
+		x:    199,
+		y:    199,
+		ax:   100,
+		ay:   100,
+		pc:   1,
+	},
+	{
+		name: "zero minting amount",
+		rx:   10000,
+		ry:   10000,
+		ps:   999,
+		x:    10,
+		y:    10,
+		ax:   0,
+		ay:   0,
+		pc:   0,
+	},
+}
+
 func TestBasicPool_Deposit(t *testing.T) {
-	for _, tc := range []struct {
-		name   string
-		rx, ry int64 // reserve balance
-		ps     int64 // pool coin supply
-		x, y   int64 // depositing coin amount
-		ax, ay int64 // expected accepted coin amount
-		pc     int64 // expected minted pool coin amount
-	}{
-		{
-			name: "ideal deposit",
-			rx:   2000,
-			ry:   100,
-			ps:   10000,
-			x:    200,
-			y:    10,
-			ax:   200,
-			ay:   10,
-			pc:   1000,
-		},
-		{
-			name: "unbalanced deposit",
-			rx:   2000,
-			ry:   100,
-			ps:   10000,
-			x:    100,
-			y:    2000,
-			ax:   100,
-			ay:   5,
-			pc:   500,
-		},
-		{
-			name: "decimal truncation",
-			rx:   222,
-			ry:   333,
-			ps:   333,
-			x:    100,
-			y:    100,
-			ax:   66,
-			ay:   99,
-			pc:   99,
-		},
-		{
-			name: "decimal truncation #2",
-			rx:   200,
-			ry:   300,
-			ps:   333,
-			x:    80,
-			y:    80,
-			ax:   53,
-			ay:   80,
-			pc:   88,
-		},
-		{
-			name: "zero minting amount",
-			ps:   100,
-			rx:   10000,
-			ry:   10000,
-			x:    99,
-			y:    99,
-			ax:   0,
-			ay:   0,
-			pc:   0,
-		},
-		{
-			name: "tiny minting amount",
-			rx:   10000,
-			ry:   10000,
-			ps:   100,
-			x:    100,
-			y:    100,
-			ax:   100,
-			ay:   100,
-			pc:   1,
-		},
-		{
-			name: "tiny minting amount #2",
-			rx:   10000,
-			ry:   10000,
-			ps:   100,
-			x:    199,
-			y:    199,
-			ax:   100,
-			ay:   100,
-			pc:   1,
-		},
-		{
-			name: "zero minting amount",
-			rx:   10000,
-			ry:   10000,
-			ps:   999,
-			x:    10,
-			y:    10,
-			ax:   0,
-			ay:   0,
-			pc:   0,
-		},
-	} {
+	for _, tc := range basicPoolDepositTestCases {
 		t.Run(tc.name, func(t *testing.T) {
 			pool := amm.NewBasicPool(math.NewInt(tc.rx), math.NewInt(tc.ry), math.NewInt(tc.ps))
 			ax, ay, pc := amm.Deposit(math.NewInt(tc.rx), math.NewInt(tc.ry), math.NewInt(tc.ps), math.NewInt(tc.x), math.NewInt(tc.y))
@@ -287,66 +289,68 @@ func TestBasicPool_Deposit(t *testing.T) {
 	}
 }
 
+var basicPoolWithdrawTestCases = []struct {
+	name    string
+	rx, ry  int64 // reserve balance
+	ps      int64 // pool coin supply
+	pc      int64 // redeeming pool coin amount
+	feeRate math.LegacyDec
+	x, y    int64 // withdrawn coin amount
+}{
+	{
+		name:    "ideal withdraw",
+		rx:      2000,
+		ry:      100,
+		ps:      10000,
+		pc:      1000,
+		feeRate: math.LegacyZeroDec(),
+		x:       200,
+		y:       10,
+	},
+	{
+		name:    "ideal withdraw - with fee",
+		rx:      2000,
+		ry:      100,
+		ps:      10000,
+		pc:      1000,
+		feeRate: math.LegacyMustNewDecFromStr("0.003"),
+		x:       199,
+		y:       9,
+	},
+	{
+		name:    "withdraw all",
+		rx:      123,
+		ry:      567,
+		ps:      10,
+		pc:      10,
+		feeRate: math.LegacyMustNewDecFromStr("0.003"),
+		x:       123,
+		y:       567,
+	},
+	{
+		name:    "advantageous for pool",
+		rx:      100,
+		ry:      100,
+		ps:      10000,
+		pc:      99,
+		feeRate: math.LegacyZeroDec(),
+		x:       0,
+		y:       0,
+	},
+	{
+		name:    "advantageous for pool",
+		rx:      10000,
+		ry:      100,
+		ps:      10000,
+		pc:      99,
+		feeRate: math.LegacyZeroDec(),
+		x:       99,
+		y:       0,
+	},
+}
+
 func TestBasicPool_Withdraw(t *testing.T) {
-	for _, tc := range []struct {
-		name    string
-		rx, ry  int64 // reserve balance
-		ps      int64 // pool coin supply
-		pc      int64 // redeeming pool coin amount
-		feeRate math.LegacyDec
-		x, y    int64 // withdrawn coin amount
-	}{
-		{
-			name:    "ideal withdraw",
-			rx:      2000,
-			ry:      100,
-			ps:      10000,
-			pc:      1000,
-			feeRate: math.LegacyZeroDec(),
-			x:       200,
-			y:       10,
-		},
-		{
-			name:    "ideal withdraw - with fee",
-			rx:      2000,
-			ry:      100,
-			ps:      10000,
-			pc:      1000,
-			feeRate: math.LegacyMustNewDecFromStr("0.003"),
-			x:       199,
-			y:       9,
-		},
-		{
-			name:    "withdraw all",
-			rx:      123,
-			ry:      567,
-			ps:      10,
-			pc:      10,
-			feeRate: math.LegacyMustNewDecFromStr("0.003"),
-			x:       123,
-			y:       567,
-		},
-		{
-			name:    "advantageous for pool",
-			rx:      100,
-			ry:      100,
-			ps:      10000,
-			pc:      99,
-			feeRate: math.LegacyZeroDec(),
-			x:       0,
-			y:       0,
-		},
-		{
-			name:    "advantageous for pool",
-			rx:      10000,
-			ry:      100,
-			ps:      10000,
-			pc:      99,
-			feeRate: math.LegacyZeroDec(),
-			x:       99,
-			y:       0,
-		},
-	} {
+	for _, tc := range basicPoolWithdrawTestCases {
 		t.Run(tc.name, func(t *testing.T) {
 			x, y := amm.Withdraw(math.NewInt(tc.rx), math.NewInt(tc.ry), math.NewInt(tc.ps), math.NewInt(tc.pc), tc.feeRate)
 			require.True(math.IntEq(t, math.NewInt(tc.x), x))
@@ -648,44 +652,46 @@ func TestCreateRangedPool(t *testing.T) {
 	}
 }
 
+var rangedPoolDepositTestCases = []struct {
+	name               string
+	rx, ry             math.Int
+	ps                 math.Int
+	minPrice, maxPrice math.LegacyDec
+	x, y               math.Int // depositing x and y coin amount
+	ax, ay             math.Int // accepted x and y coin amount
+	pc                 math.Int // expected minted pool coin amount
+}{
+	{
+		"ideal case",
+		math.NewInt(1_000000000000000000), math.NewInt(1_000000000000000000),
+		math.NewInt(1_000000000000),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"),
+		math.NewInt(123456789), math.NewInt(123456789),
+		math.NewInt(123000000), math.NewInt(123000000),
+		math.NewInt(123),
+	},
+	{
+		"single x asset pool",
+		math.NewInt(1_000000000000000000), math.NewInt(0),
+		math.NewInt(1_000000000000),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"),
+		math.NewInt(123456789), math.NewInt(0),
+		math.NewInt(123000000), math.NewInt(0),
+		math.NewInt(123),
+	},
+	{
+		"single y asset pool",
+		math.NewInt(0), math.NewInt(1_000000000000000000),
+		math.NewInt(1_000000000000),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"),
+		math.NewInt(0), math.NewInt(123456789),
+		math.NewInt(0), math.NewInt(123000000),
+		math.NewInt(123),
+	},
+}
+
 func TestRangedPool_Deposit(t *testing.T) {
-	for _, tc := range []struct {
-		name               string
-		rx, ry             math.Int
-		ps                 math.Int
-		minPrice, maxPrice math.LegacyDec
-		x, y               math.Int // depositing x and y coin amount
-		ax, ay             math.Int // accepted x and y coin amount
-		pc                 math.Int // expected minted pool coin amount
-	}{
-		{
-			"ideal case",
-			math.NewInt(1_000000000000000000), math.NewInt(1_000000000000000000),
-			math.NewInt(1_000000000000),
-			utils.ParseDec("0.5"), utils.ParseDec("2.0"),
-			math.NewInt(123456789), math.NewInt(123456789),
-			math.NewInt(123000000), math.NewInt(123000000),
-			math.NewInt(123),
-		},
-		{
-			"single x asset pool",
-			math.NewInt(1_000000000000000000), math.NewInt(0),
-			math.NewInt(1_000000000000),
-			utils.ParseDec("0.5"), utils.ParseDec("2.0"),
-			math.NewInt(123456789), math.NewInt(0),
-			math.NewInt(123000000), math.NewInt(0),
-			math.NewInt(123),
-		},
-		{
-			"single y asset pool",
-			math.NewInt(0), math.NewInt(1_000000000000000000),
-			math.NewInt(1_000000000000),
-			utils.ParseDec("0.5"), utils.ParseDec("2.0"),
-			math.NewInt(0), math.NewInt(123456789),
-			math.NewInt(0), math.NewInt(123000000),
-			math.NewInt(123),
-		},
-	} {
+	for _, tc := range rangedPoolDepositTestCases {
 		t.Run(tc.name, func(t *testing.T) {
 			pool := amm.NewRangedPool(tc.rx, tc.ry, tc.ps, tc.minPrice, tc.maxPrice)
 			ax, ay, pc := amm.Deposit(tc.rx, tc.ry, tc.ps, tc.x, tc.y)
@@ -726,40 +732,42 @@ func TestRangedPool_Deposit(t *testing.T) {
 	}
 }
 
+var rangedPoolWithdrawTestCases = []struct {
+	name               string
+	rx, ry             math.Int
+	ps                 math.Int
+	minPrice, maxPrice math.LegacyDec
+	pc                 math.Int // redeeming pool coin amount
+	x, y               math.Int // withdrawn x and y coin amount
+}{
+	{
+		"ideal case",
+		math.NewInt(1_000000000000000000), math.NewInt(1_000000000000000000),
+		math.NewInt(1_000000000000),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"),
+		math.NewInt(123),
+		math.NewInt(123000000), math.NewInt(123000000),
+	},
+	{
+		"single x asset pool",
+		math.NewInt(1_000000000000000000), math.NewInt(0),
+		math.NewInt(1_000000000000),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"),
+		math.NewInt(123),
+		math.NewInt(123000000), math.NewInt(0),
+	},
+	{
+		"single y asset pool",
+		math.NewInt(0), math.NewInt(1_000000000000000000),
+		math.NewInt(1_000000000000),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"),
+		math.NewInt(123),
+		math.NewInt(0), math.NewInt(123000000),
+	},
+}
+
 func TestRangedPool_Withdraw(t *testing.T) {
-	for _, tc := range []struct {
-		name               string
-		rx, ry             math.Int
-		ps                 math.Int
-		minPrice, maxPrice math.LegacyDec
-		pc                 math.Int // redeeming pool coin amount
-		x, y               math.Int // withdrawn x and y coin amount
-	}{
-		{
-			"ideal case",
-			math.NewInt(1_000000000000000000), math.NewInt(1_000000000000000000),
-			math.NewInt(1_000000000000),
-			utils.ParseDec("0.5"), utils.ParseDec("2.0"),
-			math.NewInt(123),
-			math.NewInt(123000000), math.NewInt(123000000),
-		},
-		{
-			"single x asset pool",
-			math.NewInt(1_000000000000000000), math.NewInt(0),
-			math.NewInt(1_000000000000),
-			utils.ParseDec("0.5"), utils.ParseDec("2.0"),
-			math.NewInt(123),
-			math.NewInt(123000000), math.NewInt(0),
-		},
-		{
-			"single y asset pool",
-			math.NewInt(0), math.NewInt(1_000000000000000000),
-			math.NewInt(1_000000000000),
-			utils.ParseDec("0.5"), utils.ParseDec("2.0"),
-			math.NewInt(123),
-			math.NewInt(0), math.NewInt(123000000),
-		},
-	} {
+	for _, tc := range rangedPoolWithdrawTestCases {
 		t.Run(tc.name, func(t *testing.T) {
 			pool := amm.NewRangedPool(tc.rx, tc.ry, tc.ps, tc.minPrice, tc.maxPrice)
 			x, y := amm.Withdraw(tc.rx, tc.ry, tc.ps, tc.pc, math.LegacyZeroDec())
@@ -843,29 +851,31 @@ func TestRangedPool_SellAmountUnder(t *testing.T) {
 	}
 }
 
-func TestRangedPool_BuyAmountTo(t *testing.T) {
-	pool := amm.NewRangedPool(
-		math.NewInt(1000000), math.NewInt(1000000), math.Int{},
-		utils.ParseDec("0.5"), utils.ParseDec("2.0"))
+var rangedPoolBuyAmountToTestPool = amm.NewRangedPool(
+	math.NewInt(1000000), math.NewInt(1000000), math.Int{},
+	utils.ParseDec("0.5"), utils.ParseDec("2.0"))
 
-	for _, tc := range []struct {
-		pool  *amm.RangedPool
-		price math.LegacyDec
-		amt   math.Int
-	}{
-		{pool, utils.ParseDec("1.1"), sdk.ZeroInt()},
-		{pool, utils.ParseDec("1.0"), sdk.ZeroInt()},
-		{pool, utils.ParseDec("0.8"), math.NewInt(450560)},
-		{pool, utils.ParseDec("0.7"), math.NewInt(796682)},
-		{
-			amm.NewRangedPool(
-				math.NewInt(957322), math.NewInt(3351038710333311), math.Int{},
-				utils.ParseDec("0.9"), utils.ParseDec("1.1"),
-			),
-			utils.ParseDec("0.899580000000000000"),
-			math.NewInt(1064187),
-		},
-	} {
+var rangedPoolBuyAmountToTestCases = []struct {
+	pool  *amm.RangedPool
+	price math.LegacyDec
+	amt   math.Int
+}{
+	{rangedPoolBuyAmountToTestPool, utils.ParseDec("1.1"), sdk.ZeroInt()},
+	{rangedPoolBuyAmountToTestPool, utils.ParseDec("1.0"), sdk.ZeroInt()},
+	{rangedPoolBuyAmountToTestPool, utils.ParseDec("0.8"), math.NewInt(450560)},
+	{rangedPoolBuyAmountToTestPool, utils.ParseDec("0.7"), math.NewInt(796682)},
+	{
+		amm.NewRangedPool(
+			math.NewInt(957322), math.NewInt(3351038710333311), math.Int{},
+			utils.ParseDec("0.9"), utils.ParseDec("1.1"),
+		),
+		utils.ParseDec("0.899580000000000000"),
+		math.NewInt(1064187),
+	},
+}
+
+func TestRangedPool_BuyAmountTo(t *testing.T) {
+	for _, tc := range rangedPoolBuyAmountToTestCases {
 		t.Run("", func(t *testing.T) {
 			amt := tc.pool.BuyAmountTo(tc.price)
 			require.True(math.IntEq(t, tc.amt, amt))
@@ -968,6 +978,16 @@ func TestRangedPool_SwapPriceOutOfRange(t *testing.T) {
 	}
 }
 
+func TestDeriveTranslation_ZeroInvSqrtPriceGap(t *testing.T) {
+	// The pool price is so close to the max price that 1/sqrt(P) - 1/sqrt(L)
+	// is zero with the limited precision, which used to panic with a division
+	// by zero.
+	transX, transY := amm.DeriveTranslation(
+		math.NewInt(999999999999999997), math.NewInt(160), utils.ParseDec("1"), utils.ParseDec("1000"))
+	require.False(t, transX.IsNegative())
+	require.False(t, transY.IsNegative())
+}
+
 func TestInitialPoolCoinSupply(t *testing.T) {
 	for _, tc := range []struct {
 		x, y math.Int
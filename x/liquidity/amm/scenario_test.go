@@ -0,0 +1,277 @@
+package amm_test
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"shogun/x/liquidity/amm"
+	"shogun/x/liquidity/keeper"
+	"shogun/x/liquidity/types"
+)
+
+var update = flag.Bool("update", false, "update golden files of order book scenarios")
+
+// scenario is an order book matching scenario parsed from a text file.
+//
+// Each non-empty line of a scenario file is one of the following
+// directives. Lines starting with '#' are comments.
+//
+//	buy <price> <amount>                          adds a buy order
+//	sell <price> <amount>                         adds a sell order
+//	pool basic <rx> <ry>                          adds a basic pool
+//	pool ranged <rx> <ry> <min-price> <max-price> adds a ranged pool
+//	last <price>                                  sets the last price of the pair
+//	tickprec <prec>                               sets the tick precision (default: 3)
+//	pricelimit <ratio>                            sets the max price limit ratio (default: 0.1)
+//
+// If the last price is set, pools place their orders within the price limits
+// around the last price and the orders are matched by OrderBook.Match.
+// Otherwise, the match price is found by FindMatchPrice and the orders are
+// matched at that single price, just like the first batch of a pair.
+type scenario struct {
+	orders          []amm.Order
+	pools           []amm.Pool
+	lastPrice       *math.LegacyDec
+	tickPrec        int
+	priceLimitRatio math.LegacyDec
+}
+
+// parseScenario parses a scenario from its text representation.
+func parseScenario(s string) (*scenario, error) {
+	sc := &scenario{
+		tickPrec:        int(defTickPrec),
+		priceLimitRatio: math.LegacyNewDecWithPrec(1, 1),
+	}
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := sc.parseLine(strings.Fields(line)); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
+
+func (sc *scenario) parseLine(fields []string) error {
+	switch fields[0] {
+	case "buy", "sell":
+		if len(fields) != 3 {
+			return fmt.Errorf("usage: %s <price> <amount>", fields[0])
+		}
+		dir := amm.Buy
+		if fields[0] == "sell" {
+			dir = amm.Sell
+		}
+		price, err := math.LegacyNewDecFromStr(fields[1])
+		if err != nil {
+			return fmt.Errorf("invalid price: %w", err)
+		}
+		amt, ok := math.NewIntFromString(fields[2])
+		if !ok || !amt.IsPositive() {
+			return fmt.Errorf("invalid amount: %s", fields[2])
+		}
+		sc.orders = append(sc.orders, newOrder(dir, price, amt))
+	case "pool":
+		if len(fields) < 2 {
+			return fmt.Errorf("usage: pool basic|ranged ...")
+		}
+		pool, err := parseScenarioPool(fields[1], fields[2:])
+		if err != nil {
+			return err
+		}
+		sc.pools = append(sc.pools, pool)
+	case "last":
+		if len(fields) != 2 {
+			return fmt.Errorf("usage: last <price>")
+		}
+		price, err := math.LegacyNewDecFromStr(fields[1])
+		if err != nil {
+			return fmt.Errorf("invalid last price: %w", err)
+		}
+		sc.lastPrice = &price
+	case "tickprec":
+		if len(fields) != 2 {
+			return fmt.Errorf("usage: tickprec <prec>")
+		}
+		tickPrec, err := strconv.Atoi(fields[1])
+		if err != nil || tickPrec < 1 {
+			return fmt.Errorf("invalid tick precision: %s", fields[1])
+		}
+		sc.tickPrec = tickPrec
+	case "pricelimit":
+		if len(fields) != 2 {
+			return fmt.Errorf("usage: pricelimit <ratio>")
+		}
+		ratio, err := math.LegacyNewDecFromStr(fields[1])
+		if err != nil || ratio.IsNegative() || ratio.GTE(math.LegacyOneDec()) {
+			return fmt.Errorf("invalid price limit ratio: %s", fields[1])
+		}
+		sc.priceLimitRatio = ratio
+	default:
+		return fmt.Errorf("unknown directive: %s", fields[0])
+	}
+	return nil
+}
+
+func parseScenarioPool(typ string, args []string) (amm.Pool, error) {
+	var ints []math.Int
+	var decs []math.LegacyDec
+	switch typ {
+	case "basic":
+		if len(args) != 2 {
+			return nil, fmt.Errorf("usage: pool basic <rx> <ry>")
+		}
+	case "ranged":
+		if len(args) != 4 {
+			return nil, fmt.Errorf("usage: pool ranged <rx> <ry> <min-price> <max-price>")
+		}
+	default:
+		return nil, fmt.Errorf("unknown pool type: %s", typ)
+	}
+	for _, arg := range args[:2] {
+		i, ok := math.NewIntFromString(arg)
+		if !ok || i.IsNegative() {
+			return nil, fmt.Errorf("invalid reserve amount: %s", arg)
+		}
+		ints = append(ints, i)
+	}
+	for _, arg := range args[2:] {
+		d, err := math.LegacyNewDecFromStr(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price: %w", err)
+		}
+		decs = append(decs, d)
+	}
+	if typ == "basic" {
+		return amm.NewBasicPool(ints[0], ints[1], math.Int{}), nil
+	}
+	if err := amm.ValidateRangedPoolParams(decs[0], decs[1], decs[0]); err != nil {
+		return nil, err
+	}
+	return amm.NewRangedPool(ints[0], ints[1], math.Int{}, decs[0], decs[1]), nil
+}
+
+// run matches the scenario's orders and returns the rendered result.
+// The orders are matched by keeper.MatchOrderBook, which is what the
+// keeper runs in each batch.
+func (sc *scenario) run() string {
+	ob := amm.NewOrderBook(sc.orders...)
+	pools := make([]*types.PoolOrderer, len(sc.pools))
+	for i, pool := range sc.pools {
+		pools[i] = types.NewPoolOrderer(pool, uint64(i+1), nil, "denom1", "denom2")
+	}
+	matchPrice, quoteCoinDust, _, matched := keeper.MatchOrderBook(
+		ob, pools, sc.lastPrice, sc.tickPrec, sc.priceLimitRatio)
+	var poolOrders []amm.Order
+	for _, order := range ob.Orders() {
+		if _, ok := order.(*types.PoolOrder); ok {
+			poolOrders = append(poolOrders, order)
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "matched: %t\n", matched)
+	if !matched {
+		return b.String()
+	}
+	fmt.Fprintf(&b, "match price: %s\n", formatScenarioDec(matchPrice))
+	fmt.Fprintf(&b, "quote coin dust: %s\n", quoteCoinDust)
+	b.WriteString("orders:\n")
+	for _, order := range sc.orders {
+		writeScenarioOrder(&b, order)
+	}
+	// Pools may place a lot of orders, so only the matched ones are shown.
+	var numMatched int
+	for _, order := range poolOrders {
+		if order.IsMatched() {
+			numMatched++
+		}
+	}
+	if len(poolOrders) > 0 {
+		fmt.Fprintf(&b, "pool orders: %d placed, %d matched\n", len(poolOrders), numMatched)
+		for _, order := range poolOrders {
+			if order.IsMatched() {
+				writeScenarioOrder(&b, order)
+			}
+		}
+	}
+	return b.String()
+}
+
+func writeScenarioOrder(b *strings.Builder, order amm.Order) {
+	fmt.Fprintf(b, "  %-4s %s %s: paid %s, received %s, open %s\n",
+		order.GetDirection(), formatScenarioDec(order.GetPrice()), order.GetAmount(),
+		order.GetPaidOfferCoinAmount(), order.GetReceivedDemandCoinAmount(), order.GetOpenAmount())
+}
+
+// formatScenarioDec formats a decimal without trailing zeros.
+func formatScenarioDec(d math.LegacyDec) string {
+	s := d.String()
+	if strings.Contains(s, ".") {
+		s = strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+func TestScenarios(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "scenarios", "*.txt"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		path := path
+		name := strings.TrimSuffix(filepath.Base(path), ".txt")
+		t.Run(name, func(t *testing.T) {
+			bz, err := os.ReadFile(path)
+			require.NoError(t, err)
+			sc, err := parseScenario(string(bz))
+			require.NoError(t, err)
+			got := sc.run()
+
+			goldenPath := strings.TrimSuffix(path, ".txt") + ".golden"
+			if *update {
+				require.NoError(t, os.WriteFile(goldenPath, []byte(got), 0o644))
+				return
+			}
+			want, err := os.ReadFile(goldenPath)
+			require.NoError(t, err, "run with -update to create the golden file")
+			require.Equal(t, string(want), got)
+		})
+	}
+}
+
+func TestParseScenario_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		s      string
+		errMsg string
+	}{
+		{"buy 1.0", "line 1: usage: buy <price> <amount>"},
+		{"# comment\nsell 1.0 abc", "line 2: invalid amount: abc"},
+		{"buy 1.0 0", "line 1: invalid amount: 0"},
+		{"pool constant 100 100", "line 1: unknown pool type: constant"},
+		{"pool ranged 100 100 1.0", "line 1: usage: pool ranged <rx> <ry> <min-price> <max-price>"},
+		{"tickprec 0", "line 1: invalid tick precision: 0"},
+		{"pricelimit 1.0", "line 1: invalid price limit ratio: 1.0"},
+		{"swap 1.0 100", "line 1: unknown directive: swap"},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			_, err := parseScenario(tc.s)
+			require.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
@@ -32,22 +32,24 @@ func TestPriceToTick(t *testing.T) {
 	}
 }
 
+var tickTestCases = []struct {
+	i        int
+	prec     int
+	expected math.LegacyDec
+}{
+	{0, 3, math.LegacyNewDecWithPrec(1, int64(sdk.Precision-defTickPrec))},
+	{1, 3, utils.ParseDec("0.000000000000001001")},
+	{8999, 3, utils.ParseDec("0.000000000000009999")},
+	{9000, 3, utils.ParseDec("0.000000000000010000")},
+	{9001, 3, utils.ParseDec("0.000000000000010010")},
+	{17999, 3, utils.ParseDec("0.000000000000099990")},
+	{18000, 3, utils.ParseDec("0.000000000000100000")},
+	{135000, 3, math.LegacyNewDec(1)},
+	{135001, 3, utils.ParseDec("1.001")},
+}
+
 func TestTick(t *testing.T) {
-	for _, tc := range []struct {
-		i        int
-		prec     int
-		expected math.LegacyDec
-	}{
-		{0, 3, math.LegacyNewDecWithPrec(1, int64(sdk.Precision-defTickPrec))},
-		{1, 3, utils.ParseDec("0.000000000000001001")},
-		{8999, 3, utils.ParseDec("0.000000000000009999")},
-		{9000, 3, utils.ParseDec("0.000000000000010000")},
-		{9001, 3, utils.ParseDec("0.000000000000010010")},
-		{17999, 3, utils.ParseDec("0.000000000000099990")},
-		{18000, 3, utils.ParseDec("0.000000000000100000")},
-		{135000, 3, math.LegacyNewDec(1)},
-		{135001, 3, utils.ParseDec("1.001")},
-	} {
+	for _, tc := range tickTestCases {
 		t.Run("", func(t *testing.T) {
 			res := amm.TickFromIndex(tc.i, tc.prec)
 			require.True(math.LegacyDecEq(t, tc.expected, res))
@@ -0,0 +1,164 @@
+// Package ante implements an ante decorator letting transaction fees be paid
+// in non-native coins, which are valued and converted to the native coin
+// through the liquidity module.
+package ante
+
+import (
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"shogun/x/liquidity/keeper"
+)
+
+// DefaultFeeHaircut is the default discount applied to the value of fees paid
+// in non-native coins, covering the price movement until they are converted.
+var DefaultFeeHaircut = sdkmath.LegacyNewDecWithPrec(1, 1) // 10%
+
+// StakingKeeper is the expected staking keeper, which defines the native coin.
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+}
+
+// DeductFeeDecorator deducts fees from the fee payer of the tx like the auth
+// module's DeductFeeDecorator, which it wraps.
+// A fee of a single coin of the liquidity module's fee denoms is valued in
+// the native coin at the price of the pools against the native coin,
+// discounted by the haircut, and collected by the liquidity module to be
+// converted to the native coin at the end of the batch. Other fees are
+// handled by the wrapped decorator.
+// CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
+type DeductFeeDecorator struct {
+	accountKeeper  ante.AccountKeeper
+	feegrantKeeper ante.FeegrantKeeper
+	stakingKeeper  StakingKeeper
+	keeper         keeper.Keeper
+	haircut        sdkmath.LegacyDec
+	native         ante.DeductFeeDecorator
+}
+
+// NewDeductFeeDecorator returns a new DeductFeeDecorator.
+// tfc is used for fees in the native coin, and is the auth module's default
+// checker if nil.
+func NewDeductFeeDecorator(
+	ak ante.AccountKeeper, bk authtypes.BankKeeper, fk ante.FeegrantKeeper, sk StakingKeeper,
+	k keeper.Keeper, haircut sdkmath.LegacyDec, tfc ante.TxFeeChecker) DeductFeeDecorator {
+	if haircut.IsNegative() || haircut.GTE(sdkmath.LegacyOneDec()) {
+		panic("fee haircut must be in [0, 1)")
+	}
+	return DeductFeeDecorator{
+		accountKeeper:  ak,
+		feegrantKeeper: fk,
+		stakingKeeper:  sk,
+		keeper:         k,
+		haircut:        haircut,
+		native:         ante.NewDeductFeeDecorator(ak, bk, fk, tfc),
+	}
+}
+
+func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	nativeDenom := dfd.stakingKeeper.BondDenom(ctx)
+	fee := feeTx.GetFee()
+	if len(fee) != 1 || fee[0].Denom == nativeDenom {
+		return dfd.native.AnteHandle(ctx, tx, simulate, next)
+	}
+	feeCoin := fee[0]
+	value, err := dfd.keeper.FeeValue(ctx, feeCoin, nativeDenom, dfd.haircut)
+	if err != nil {
+		// Fees which can't be converted are handled as before, so they are
+		// accepted only if the validator's min gas prices allow them.
+		return dfd.native.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	var priority int64
+	if !simulate {
+		// Like the auth module's default checker, the min gas price is
+		// checked only in CheckTx.
+		if ctx.IsCheckTx() {
+			minGasPrice := ctx.MinGasPrices().AmountOf(nativeDenom)
+			if minGasPrice.IsPositive() {
+				required := minGasPrice.MulInt64(int64(feeTx.GetGas())).Ceil().RoundInt()
+				if value.Amount.LT(required) {
+					return ctx, sdkerrors.Wrapf(
+						sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s worth %s required: %s",
+						feeCoin, value, sdk.NewCoin(nativeDenom, required))
+				}
+			}
+		}
+		priority = txPriority(value, int64(feeTx.GetGas()))
+	}
+
+	if err := dfd.deductFee(ctx, tx, feeCoin); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// deductFee deducts the fee coin from the fee payer, or the fee granter if
+// set, and collects it in the liquidity module.
+func (dfd DeductFeeDecorator) deductFee(ctx sdk.Context, sdkTx sdk.Tx, feeCoin sdk.Coin) error {
+	feeTx := sdkTx.(sdk.FeeTx)
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+	deductFeesFrom := feePayer
+
+	if feeGranter != nil {
+		if dfd.feegrantKeeper == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+		} else if !feeGranter.Equals(feePayer) {
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, sdk.NewCoins(feeCoin), sdkTx.GetMsgs())
+			if err != nil {
+				return sdkerrors.Wrapf(err, "%s does not not allow to pay fees for %s", feeGranter, feePayer)
+			}
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	if acc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom); acc == nil {
+		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	if feeCoin.IsPositive() {
+		if err := dfd.keeper.CollectFee(ctx, deductFeesFrom, feeCoin); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, feeCoin.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFeesFrom.String()),
+		),
+	)
+
+	return nil
+}
+
+// txPriority returns the priority of a tx by its fee value in the native coin
+// per gas, like the auth module's default checker does.
+func txPriority(value sdk.Coin, gas int64) int64 {
+	if gas <= 0 {
+		return 0
+	}
+	p := value.Amount.QuoRaw(gas)
+	if !p.IsInt64() {
+		return math.MaxInt64
+	}
+	return p.Int64()
+}
@@ -0,0 +1,266 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	chain "shogun/app"
+	utils "shogun/types"
+	"shogun/x/liquidity/ante"
+	"shogun/x/liquidity/types"
+)
+
+type FeeTestSuite struct {
+	suite.Suite
+
+	app       *chain.App
+	ctx       sdk.Context
+	txConfig  client.TxConfig
+	decorator ante.DeductFeeDecorator
+
+	payer sdk.AccAddress
+	pair  types.Pair
+}
+
+func TestFeeTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeTestSuite))
+}
+
+func (s *FeeTestSuite) SetupTest() {
+	s.app = chain.Setup(false)
+	hdr := tmproto.Header{
+		Height: 1,
+		Time:   utils.ParseTime("2022-01-01T00:00:00Z"),
+	}
+	s.app.BeginBlock(abci.RequestBeginBlock{Header: hdr})
+	s.ctx = s.app.BaseApp.NewContext(false, hdr)
+	s.txConfig = chain.MakeEncodingConfig().TxConfig
+	s.decorator = ante.NewDeductFeeDecorator(
+		s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.StakingKeeper,
+		s.app.LiquidityKeeper, ante.DefaultFeeHaircut, nil)
+
+	params := s.app.LiquidityKeeper.GetParams(s.ctx)
+	params.FeeDenoms = []string{"denom1", "denom2"}
+	params.MinFeePoolReserve = sdk.NewInt(1_000_000_000)
+	s.app.LiquidityKeeper.SetParams(s.ctx, params)
+
+	s.payer = sdk.AccAddress(crypto.AddressHash([]byte("payer")))
+	s.fundAddr(s.payer, utils.ParseCoins("1000000denom1,1000000denom2,1000000stake"))
+	s.pair = s.createPairWithPool("denom1", "stake")
+}
+
+func (s *FeeTestSuite) fundAddr(addr sdk.AccAddress, amt sdk.Coins) {
+	s.T().Helper()
+	s.Require().NoError(chain.FundAccount(s.app.BankKeeper, s.ctx, addr, amt))
+}
+
+// createPairWithPool creates a pair with a pool at price 1.
+func (s *FeeTestSuite) createPairWithPool(baseDenom, quoteDenom string) types.Pair {
+	k := s.app.LiquidityKeeper
+	creator := sdk.AccAddress(crypto.AddressHash([]byte("creator")))
+	depositCoins := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1_000_000_000), sdk.NewInt64Coin(quoteDenom, 1_000_000_000))
+	s.fundAddr(creator, depositCoins.Add(k.GetPairCreationFee(s.ctx)...).Add(k.GetPoolCreationFee(s.ctx)...))
+
+	pair, err := k.CreatePair(s.ctx, types.NewMsgCreatePair(creator, baseDenom, quoteDenom))
+	s.Require().NoError(err)
+	_, err = k.CreatePool(s.ctx, types.NewMsgCreatePool(creator, pair.Id, depositCoins))
+	s.Require().NoError(err)
+	lastPrice := utils.ParseDec("1.0")
+	pair.LastPrice = &lastPrice
+	k.SetPair(s.ctx, pair)
+	return pair
+}
+
+func (s *FeeTestSuite) nextBlock() {
+	s.T().Helper()
+	s.app.EndBlock(abci.RequestEndBlock{})
+	s.app.Commit()
+	hdr := tmproto.Header{
+		Height: s.app.LastBlockHeight() + 1,
+		Time:   s.ctx.BlockTime().Add(5 * time.Second),
+	}
+	s.app.BeginBlock(abci.RequestBeginBlock{Header: hdr})
+	s.ctx = s.app.BaseApp.NewContext(false, hdr)
+}
+
+func (s *FeeTestSuite) feeTx(fee sdk.Coins, gas uint64) sdk.Tx {
+	txBuilder := s.txConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(
+		banktypes.NewMsgSend(s.payer, sdk.AccAddress(crypto.AddressHash([]byte("receiver"))), utils.ParseCoins("1stake"))))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gas)
+	return txBuilder.GetTx()
+}
+
+// handle runs the decorator with the tx and returns the context passed to the
+// next ante handler.
+func (s *FeeTestSuite) handle(ctx sdk.Context, tx sdk.Tx) (sdk.Context, error) {
+	var nextCtx sdk.Context
+	_, err := s.decorator.AnteHandle(ctx, tx, false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextCtx = ctx
+		return ctx, nil
+	})
+	return nextCtx, err
+}
+
+func (s *FeeTestSuite) feeCollectorBalances() sdk.Coins {
+	return s.app.BankKeeper.GetAllBalances(s.ctx, s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+}
+
+func (s *FeeTestSuite) TestNativeFee() {
+	before := s.feeCollectorBalances()
+	_, err := s.handle(s.ctx, s.feeTx(utils.ParseCoins("1000stake"), 200000))
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseCoins("1000stake"), s.feeCollectorBalances().Sub(before...))
+}
+
+func (s *FeeTestSuite) TestNonNativeFee() {
+	ctx, err := s.handle(s.ctx, s.feeTx(utils.ParseCoins("1000denom1"), 200))
+	s.Require().NoError(err)
+	// 1000denom1 is worth 900stake after the 10% haircut.
+	s.Require().Equal(int64(900/200), ctx.Priority())
+	s.Require().Equal("999000denom1", s.app.BankKeeper.GetBalance(s.ctx, s.payer, "denom1").String())
+
+	// The fee is kept until the end of the batch.
+	s.Require().Equal("1000denom1", s.app.BankKeeper.GetBalance(s.ctx, types.FeeAbstractionAddress, "denom1").String())
+	s.Require().Empty(s.app.LiquidityKeeper.GetAllOrders(s.ctx))
+
+	// The fee is converted by a market order at the end of the batch.
+	s.nextBlock()
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, types.FeeAbstractionAddress, "denom1").IsZero())
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, types.FeeAbstractionAddress, "stake").IsPositive())
+}
+
+func (s *FeeTestSuite) TestConvertFees() {
+	s.fundAddr(types.FeeAbstractionAddress, utils.ParseCoins("1000denom1,500stake"))
+
+	before := s.feeCollectorBalances()
+	s.app.LiquidityKeeper.ConvertFees(s.ctx)
+	// The converted coins are sent to the fee collector.
+	s.Require().Equal(utils.ParseCoins("500stake"), s.feeCollectorBalances().Sub(before...))
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, types.FeeAbstractionAddress, "stake").IsZero())
+
+	orders := s.app.LiquidityKeeper.GetAllOrders(s.ctx)
+	s.Require().Len(orders, 1)
+	s.Require().Equal(types.FeeAbstractionAddress.String(), orders[0].Orderer)
+	s.Require().Equal(types.OrderTypeMarket, orders[0].Type)
+	s.Require().Equal(types.OrderDirectionSell, orders[0].Direction)
+	s.Require().Equal(utils.ParseCoin("1000denom1"), orders[0].OfferCoin)
+}
+
+func (s *FeeTestSuite) TestConvertFeesQuoteCoin() {
+	pair := s.createPairWithPool("stake", "denom2")
+	s.fundAddr(types.FeeAbstractionAddress, utils.ParseCoins("1000denom2"))
+	s.app.LiquidityKeeper.ConvertFees(s.ctx)
+
+	orders := s.app.LiquidityKeeper.GetAllOrders(s.ctx)
+	s.Require().Len(orders, 1)
+	s.Require().Equal(pair.Id, orders[0].PairId)
+	s.Require().Equal(types.OrderDirectionBuy, orders[0].Direction)
+}
+
+func (s *FeeTestSuite) TestSmallFeeNotConverted() {
+	// The fee is kept even if it's too small to be ordered.
+	s.fundAddr(types.FeeAbstractionAddress, utils.ParseCoins("10denom1"))
+	s.app.LiquidityKeeper.ConvertFees(s.ctx)
+	s.Require().Empty(s.app.LiquidityKeeper.GetAllOrders(s.ctx))
+	s.Require().Equal("10denom1", s.app.BankKeeper.GetBalance(s.ctx, types.FeeAbstractionAddress, "denom1").String())
+
+	// The collected coins are converted with the later fees.
+	s.fundAddr(types.FeeAbstractionAddress, utils.ParseCoins("1000denom1"))
+	s.app.LiquidityKeeper.ConvertFees(s.ctx)
+	orders := s.app.LiquidityKeeper.GetAllOrders(s.ctx)
+	s.Require().Len(orders, 1)
+	s.Require().Equal(utils.ParseCoin("1010denom1"), orders[0].OfferCoin)
+}
+
+func (s *FeeTestSuite) TestFeeValueLowestPoolPrice() {
+	// A pool at a higher price doesn't raise the value of the fee.
+	k := s.app.LiquidityKeeper
+	creator := sdk.AccAddress(crypto.AddressHash([]byte("creator")))
+	depositCoins := utils.ParseCoins("1000000000denom1,2000000000stake")
+	s.fundAddr(creator, depositCoins.Add(k.GetPoolCreationFee(s.ctx)...))
+	_, err := k.CreateRangedPool(s.ctx, types.NewMsgCreateRangedPool(
+		creator, s.pair.Id, depositCoins, utils.ParseDec("1.5"), utils.ParseDec("2.5"), utils.ParseDec("2.0")))
+	s.Require().NoError(err)
+
+	value, err := k.FeeValue(s.ctx, utils.ParseCoin("1000denom1"), "stake", ante.DefaultFeeHaircut)
+	s.Require().NoError(err)
+	s.Require().Equal("900stake", value.String())
+
+	// Without the pool at price 1, the other pool's price of about 2 is
+	// taken.
+	pool, _ := k.GetPool(s.ctx, 1)
+	pool.Disabled = true
+	k.SetPool(s.ctx, pool)
+	value, err = k.FeeValue(s.ctx, utils.ParseCoin("1000denom1"), "stake", ante.DefaultFeeHaircut)
+	s.Require().NoError(err)
+	s.Require().InDelta(1800, value.Amount.Int64(), 10)
+}
+
+func (s *FeeTestSuite) TestMinGasPrice() {
+	ctx := s.ctx.WithIsCheckTx(true).WithMinGasPrices(utils.ParseDecCoins("0.001stake"))
+	// 1000denom1 is worth 900stake, which covers 200000 gas at 0.001stake but
+	// not 1000000 gas.
+	_, err := s.handle(ctx, s.feeTx(utils.ParseCoins("1000denom1"), 200000))
+	s.Require().NoError(err)
+	_, err = s.handle(ctx, s.feeTx(utils.ParseCoins("1000denom1"), 1000000))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+}
+
+func (s *FeeTestSuite) TestNotConvertible() {
+	for _, tc := range []struct {
+		name     string
+		malleate func()
+		fee      string
+	}{
+		{"no pair", func() {}, "1000denom2"},
+		{"not a fee denom", func() {
+			params := s.app.LiquidityKeeper.GetParams(s.ctx)
+			params.FeeDenoms = []string{"denom2"}
+			s.app.LiquidityKeeper.SetParams(s.ctx, params)
+		}, "1000denom1"},
+		{"shallow pool", func() {
+			params := s.app.LiquidityKeeper.GetParams(s.ctx)
+			params.MinFeePoolReserve = sdk.NewInt(1_000_000_001)
+			s.app.LiquidityKeeper.SetParams(s.ctx, params)
+		}, "1000denom1"},
+		{"no last price", func() {
+			s.pair.LastPrice = nil
+			s.app.LiquidityKeeper.SetPair(s.ctx, s.pair)
+		}, "1000denom1"},
+		{"no enabled pool", func() {
+			pool, _ := s.app.LiquidityKeeper.GetPool(s.ctx, 1)
+			pool.Disabled = true
+			s.app.LiquidityKeeper.SetPool(s.ctx, pool)
+		}, "1000denom1"},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+			fee := utils.ParseCoins(tc.fee)
+
+			// The fee is handled by the auth module, which rejects it under
+			// min gas prices in the native coin only.
+			ctx := s.ctx.WithIsCheckTx(true).WithMinGasPrices(utils.ParseDecCoins("0.001stake"))
+			_, err := s.handle(ctx, s.feeTx(fee, 200))
+			s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+			before := s.feeCollectorBalances()
+			_, err = s.handle(s.ctx, s.feeTx(fee, 200))
+			s.Require().NoError(err)
+			s.Require().Equal(fee, s.feeCollectorBalances().Sub(before...))
+			s.Require().Empty(s.app.LiquidityKeeper.GetAllOrders(s.ctx))
+		})
+	}
+}
@@ -3,6 +3,8 @@ package cli
 // DONTCOVER
 
 import (
+	"time"
+
 	flag "github.com/spf13/pflag"
 )
 
@@ -14,6 +16,18 @@ const (
 	FlagDenoms         = "denoms"
 	FlagOrderLifespan  = "order-lifespan"
 	FlagNumTicks       = "num-ticks"
+	FlagStatus         = "status"
+	FlagDirection      = "direction"
+	FlagOrderType      = "order-type"
+	FlagMinBatchId     = "min-batch-id"
+	FlagSort           = "sort"
+
+	FlagForwardChannel  = "forward-channel"
+	FlagForwardReceiver = "forward-receiver"
+	FlagForwardTimeout  = "forward-timeout"
+
+	FlagICAVersion    = "ica-version"
+	FlagPacketTimeout = "packet-timeout"
 )
 
 func flagSetPools() *flag.FlagSet {
@@ -45,6 +59,19 @@ func flagSetPairs() *flag.FlagSet {
 func flagSetOrders() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
+	fs.String(FlagPairId, "", "The pair id")
+	fs.String(FlagStatus, "", "The order status; not-executed|not-matched|partially-matched|completed|canceled|expired")
+	fs.String(FlagDirection, "", "The order direction; buy|sell")
+	fs.String(FlagOrderType, "", "The order type; limit|market|mm")
+	fs.Uint64(FlagMinBatchId, 0, "The minimum batch id of orders")
+	fs.String(FlagSort, "asc", "The sort order of orders by id; asc|desc")
+
+	return fs
+}
+
+func flagSetMMOrders() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
 	fs.String(FlagPairId, "", "The pair id")
 
 	return fs
@@ -57,3 +84,21 @@ func flagSetOrder() *flag.FlagSet {
 
 	return fs
 }
+
+func flagSetIBCForward() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagForwardChannel, "", "The transfer channel to send the received coin over when the order is finished, instead of sending it to the orderer")
+	fs.String(FlagForwardReceiver, "", "The receiver address on the destination chain of the forward channel")
+	fs.Duration(FlagForwardTimeout, 10*time.Minute, "Timeout of the transfer of the received coin, relative to the block time when the order is finished")
+
+	return fs
+}
+
+func flagSetICA() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Duration(FlagPacketTimeout, 10*time.Minute, "Timeout of the interchain account packet, relative to the current block time")
+
+	return fs
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"shogun/x/liquidity/types"
@@ -34,9 +35,14 @@ func GetQueryCmd() *cobra.Command {
 		NewQueryDepositRequestCmd(),
 		NewQueryWithdrawRequestsCmd(),
 		NewQueryWithdrawRequestCmd(),
+		NewQuerySimulateDepositCmd(),
+		NewQuerySimulateWithdrawCmd(),
+		NewQueryPositionsCmd(),
 		NewQueryOrdersCmd(),
 		NewQueryOrderCmd(),
+		NewQueryMMOrdersCmd(),
 		NewQueryOrderBooksCmd(),
+		NewQueryICARequestCmd(),
 	)
 
 	return cmd
@@ -527,6 +533,154 @@ $ %s query %s withdraw-requests 1 1
 	return cmd
 }
 
+// NewQuerySimulateDepositCmd implements the simulate-deposit query command.
+func NewQuerySimulateDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-deposit [pool-id] [deposit-coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Simulate a deposit to the pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate a deposit to the pool against the current reserves.
+The result shows the coins which would be accepted and refunded, and the pool coin which would be minted.
+
+Example:
+$ %s query %s simulate-deposit 1 1000000000uatom,50000000000ucre
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateDeposit(
+				cmd.Context(),
+				&types.QuerySimulateDepositRequest{
+					PoolId:       poolId,
+					DepositCoins: depositCoins,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQuerySimulateWithdrawCmd implements the simulate-withdraw query command.
+func NewQuerySimulateWithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-withdraw [pool-id] [pool-coin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Simulate a withdrawal from the pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate a withdrawal of the pool coin from the pool against the current reserves.
+The result shows the coins which would be withdrawn after the withdraw fee, and the withdraw fee.
+
+Example:
+$ %s query %s simulate-withdraw 1 10000pool1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			poolCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateWithdraw(
+				cmd.Context(),
+				&types.QuerySimulateWithdrawRequest{
+					PoolId:   poolId,
+					PoolCoin: poolCoin,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryPositionsCmd implements the positions query command.
+func NewQueryPositionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query liquidity positions of the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query liquidity positions of the account in pools, with its pending deposit and withdraw requests.
+The redeemable coins of a position are after the withdraw fee, and its value is in the quote coin at the current pool price.
+
+Example:
+$ %s query %s positions cre1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Positions(
+				cmd.Context(),
+				&types.QueryPositionsRequest{
+					Address: args[0],
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewQueryOrdersCmd implements the orders query command.
 func NewQueryOrdersCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -540,10 +694,14 @@ Example:
 $ %s query %s orders cre1...
 $ %s query %s orders --pair-id=1 cre1...
 $ %s query %s orders --pair-id=1
+$ %s query %s orders --pair-id=1 --status=completed --direction=buy cre1...
+$ %s query %s orders --pair-id=1 --order-type=mm --min-batch-id=100 --sort=desc
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -574,6 +732,41 @@ $ %s query %s orders --pair-id=1
 				return fmt.Errorf("either orderer or pair-id must be specified")
 			}
 
+			var orderStatus types.OrderStatus
+			statusStr, _ := cmd.Flags().GetString(FlagStatus)
+			if statusStr != "" {
+				orderStatus, err = parseOrderStatus(statusStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			var dir types.OrderDirection
+			dirStr, _ := cmd.Flags().GetString(FlagDirection)
+			if dirStr != "" {
+				dir, err = parseOrderDirection(dirStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			var orderType types.OrderType
+			orderTypeStr, _ := cmd.Flags().GetString(FlagOrderType)
+			if orderTypeStr != "" {
+				orderType, err = parseOrderType(orderTypeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			minBatchId, _ := cmd.Flags().GetUint64(FlagMinBatchId)
+
+			sortStr, _ := cmd.Flags().GetString(FlagSort)
+			sort, err := parseOrderSort(sortStr)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			var res *types.QueryOrdersResponse
@@ -581,6 +774,11 @@ $ %s query %s orders --pair-id=1
 				res, err = queryClient.Orders(cmd.Context(), &types.QueryOrdersRequest{
 					PairId:     pairId,
 					Pagination: pageReq,
+					Status:     orderStatus,
+					Direction:  dir,
+					OrderType:  orderType,
+					MinBatchId: minBatchId,
+					Sort:       sort,
 				})
 			} else {
 				res, err = queryClient.OrdersByOrderer(
@@ -589,6 +787,11 @@ $ %s query %s orders --pair-id=1
 						Orderer:    *orderer,
 						PairId:     pairId,
 						Pagination: pageReq,
+						Status:     orderStatus,
+						Direction:  dir,
+						OrderType:  orderType,
+						MinBatchId: minBatchId,
+						Sort:       sort,
 					})
 			}
 			if err != nil {
@@ -658,6 +861,78 @@ $ %s query %s order 1 1
 	return cmd
 }
 
+// NewQueryMMOrdersCmd implements the mm-orders query command.
+func NewQueryMMOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mm-orders [orderer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for market making orders made by the orderer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for market making orders made by the orderer, optionally in the pair.
+
+Example:
+$ %s query %s mm-orders cre1...
+$ %s query %s mm-orders --pair-id=1 cre1...
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var pairId uint64
+			pairIdStr, _ := cmd.Flags().GetString(FlagPairId)
+			if pairIdStr != "" {
+				pairId, err = strconv.ParseUint(pairIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pair id: %w", err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if pairId == 0 {
+				res, err := queryClient.MMOrdersByOrderer(
+					cmd.Context(),
+					&types.QueryMMOrdersByOrdererRequest{
+						Orderer:    args[0],
+						Pagination: pageReq,
+					})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.MMOrders(
+				cmd.Context(),
+				&types.QueryMMOrdersRequest{
+					Orderer: args[0],
+					PairId:  pairId,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetMMOrders())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mm-orders")
+
+	return cmd
+}
+
 // NewQueryOrderBooksCmd implements the order books query command.
 func NewQueryOrderBooksCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -714,3 +989,52 @@ $ %s query %s order-books 2,3
 
 	return cmd
 }
+
+// NewQueryICARequestCmd implements the ica request query command.
+func NewQueryICARequestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-request [channel-id] [sequence]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query details of the specific ICA request",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of the specific request sent through an interchain account.
+The channel id and the sequence are returned by the ica-mm-order and
+ica-cancel-mm-order transactions.
+
+Example:
+$ %s query %s ica-request channel-0 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ICARequest(
+				cmd.Context(),
+				&types.QueryICARequestRequest{
+					ChannelId: args[0],
+					Sequence:  sequence,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
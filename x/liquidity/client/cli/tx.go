@@ -39,6 +39,9 @@ func GetTxCmd() *cobra.Command {
 		NewCancelOrderCmd(),
 		NewCancelAllOrdersCmd(),
 		NewCancelMMOrderCmd(),
+		NewRegisterICACmd(),
+		NewICAMMOrderCmd(),
+		NewICACancelMMOrderCmd(),
 	)
 
 	return cmd
@@ -280,6 +283,7 @@ $ %s tx %s limit-order 1 buy 5000stake uatom 0.5 10000 --from mykey
 $ %s tx %s limit-order 1 b 5000stake uatom 0.5 10000 --from mykey
 $ %s tx %s limit-order 1 sell 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
 $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
+$ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --forward-channel=channel-0 --forward-receiver=cosmos1... --from mykey
 
 [pair-id]: pair id to swap with
 [direction]: order direction (one of: buy,b,sell,s)
@@ -287,11 +291,15 @@ $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --fro
 [demand-coin-denom]: the denom to exchange with the offer coin
 [price]: the limit order price for the swap; the exchange ratio is X/Y where X is the amount of quote coin and Y is the amount of base coin
 [amount]: the amount of base coin to buy or sell
+
+The coin received by the order is sent over IBC when the order is finished if --forward-channel is given.
+If the transfer fails or times out, the coin is refunded to the orderer.
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -332,6 +340,11 @@ $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --fro
 
 			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
 
+			forward, err := parseIBCForward(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgLimitOrder(
 				clientCtx.GetFromAddress(),
 				pairId,
@@ -342,12 +355,14 @@ $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --fro
 				amt,
 				orderLifespan,
 			)
+			msg.Forward = forward
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().AddFlagSet(flagSetIBCForward())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -366,17 +381,22 @@ $ %s tx %s market-order 1 buy 5000stake uatom 10000 --from mykey
 $ %s tx %s market-order 1 b 5000stake uatom 10000 --from mykey
 $ %s tx %s market-order 1 sell 10000uatom stake 10000 --order-lifespan=10m --from mykey
 $ %s tx %s market-order 1 s 10000uatom stake 10000 --order-lifespan=10m --from mykey
+$ %s tx %s market-order 1 s 10000uatom stake 10000 --forward-channel=channel-0 --forward-receiver=cosmos1... --from mykey
 
 [pair-id]: pair id to swap with
 [direction]: order direction (one of: buy,b,sell,s)
 [offer-coin]: the amount of offer coin to swap
 [demand-coin-denom]: the denom to exchange with the offer coin
 [amount]: the amount of base coin to buy or sell
+
+The coin received by the order is sent over IBC when the order is finished if --forward-channel is given.
+If the transfer fails or times out, the coin is refunded to the orderer.
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -412,6 +432,11 @@ $ %s tx %s market-order 1 s 10000uatom stake 10000 --order-lifespan=10m --from m
 
 			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
 
+			forward, err := parseIBCForward(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgMarketOrder(
 				clientCtx.GetFromAddress(),
 				pairId,
@@ -421,12 +446,14 @@ $ %s tx %s market-order 1 s 10000uatom stake 10000 --order-lifespan=10m --from m
 				amt,
 				orderLifespan,
 			)
+			msg.Forward = forward
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().AddFlagSet(flagSetIBCForward())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -644,3 +671,176 @@ $ %s tx %s cancel-mm-order 1 --from mykey
 
 	return cmd
 }
+
+func NewRegisterICACmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-ica [connection-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Register an interchain account for remote market making",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register an interchain account on the host chain of the connection.
+The interchain account is owned by the sender, and is used to make market making
+orders on the host chain with ica-mm-order and ica-cancel-mm-order.
+The default interchain account version is used unless --ica-version is given.
+
+Example:
+$ %s tx %s register-ica connection-0 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			icaVersion, _ := cmd.Flags().GetString(FlagICAVersion)
+
+			msg := types.NewMsgRegisterICA(clientCtx.GetFromAddress(), args[0], icaVersion)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagICAVersion, "", "The interchain account version metadata in JSON")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewICAMMOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-mm-order [connection-id] [pair-id] [max-sell-price] [min-sell-price] [sell-amount] [max-buy-price] [min-buy-price] [buy-amount]",
+		Args:  cobra.ExactArgs(8),
+		Short: "Make a market making order on the host chain through an interchain account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make a market making order on the host chain of the connection through the
+sender's interchain account.
+The arguments are the same as the ones of mm-order, except for the connection id.
+The ids of the orders made are recorded when the packet is acknowledged, and
+can be queried with the ica-request query.
+
+Example:
+$ %s tx %s ica-mm-order connection-0 1 102 101 10000 100 99 10000 --from mykey
+$ %s tx %s ica-mm-order connection-0 1 102 101 10000 100 99 10000 --packet-timeout 5m --from mykey
+
+[connection-id]: connection id of the host chain
+[pair-id]: pair id on the host chain to make order
+[max-sell-price]: maximum price of sell orders
+[min-sell-price]]: minimum price of sell orders
+[sell-amount]: total amount of sell orders
+[max-buy-price]: maximum price of buy orders
+[min-buy-price]: minimum price of buy orders
+[buy-amount]: the total amount of buy orders
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			maxSellPrice, err := math.LegacyNewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid max sell price: %w", err)
+			}
+
+			minSellPrice, err := math.LegacyNewDecFromStr(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid min sell price: %w", err)
+			}
+
+			sellAmt, ok := math.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid sell amount: %s", args[4])
+			}
+
+			maxBuyPrice, err := math.LegacyNewDecFromStr(args[5])
+			if err != nil {
+				return fmt.Errorf("invalid max buy price: %w", err)
+			}
+
+			minBuyPrice, err := math.LegacyNewDecFromStr(args[6])
+			if err != nil {
+				return fmt.Errorf("invalid min buy price: %w", err)
+			}
+
+			buyAmt, ok := math.NewIntFromString(args[7])
+			if !ok {
+				return fmt.Errorf("invalid buy amount: %s", args[7])
+			}
+
+			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
+			timeout, _ := cmd.Flags().GetDuration(FlagPacketTimeout)
+
+			msg := types.NewMsgICAMMOrder(
+				clientCtx.GetFromAddress(),
+				args[0],
+				pairId,
+				maxSellPrice, minSellPrice, sellAmt,
+				maxBuyPrice, minBuyPrice, buyAmt,
+				orderLifespan,
+				timeout,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().AddFlagSet(flagSetICA())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewICACancelMMOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-cancel-mm-order [connection-id] [pair-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel the mm order in a pair on the host chain through an interchain account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the mm order in a pair on the host chain of the connection through the
+sender's interchain account.
+The ids of the canceled orders are recorded when the packet is acknowledged, and
+can be queried with the ica-request query.
+
+Example:
+$ %s tx %s ica-cancel-mm-order connection-0 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			timeout, _ := cmd.Flags().GetDuration(FlagPacketTimeout)
+
+			msg := types.NewMsgICACancelMMOrder(clientCtx.GetFromAddress(), args[0], pairId, timeout)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetICA())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
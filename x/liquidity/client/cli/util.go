@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	flag "github.com/spf13/pflag"
+
 	"shogun/x/liquidity/types"
 )
 
@@ -29,3 +31,62 @@ func parseOrderDirection(s string) (types.OrderDirection, error) {
 	}
 	return 0, fmt.Errorf("invalid order direction: %s", s)
 }
+
+// parseOrderStatus parses order status string like "partially-matched" and
+// returns types.OrderStatus.
+func parseOrderStatus(s string) (types.OrderStatus, error) {
+	v, ok := types.OrderStatus_value["ORDER_STATUS_"+enumName(s)]
+	if !ok || v == int32(types.OrderStatusUnspecified) {
+		return 0, fmt.Errorf("invalid order status: %s", s)
+	}
+	return types.OrderStatus(v), nil
+}
+
+// parseOrderType parses order type string and returns types.OrderType.
+func parseOrderType(s string) (types.OrderType, error) {
+	v, ok := types.OrderType_value["ORDER_TYPE_"+enumName(s)]
+	if !ok || v == int32(types.OrderTypeUnspecified) {
+		return 0, fmt.Errorf("invalid order type: %s", s)
+	}
+	return types.OrderType(v), nil
+}
+
+// parseOrderSort parses order sort string and returns types.OrderSort.
+func parseOrderSort(s string) (types.OrderSort, error) {
+	switch strings.ToLower(s) {
+	case "asc":
+		return types.OrderSortIdAsc, nil
+	case "desc":
+		return types.OrderSortIdDesc, nil
+	}
+	return 0, fmt.Errorf("invalid order sort: %s", s)
+}
+
+// enumName returns the enum value name suffix of the string, which is in
+// kebab case.
+func enumName(s string) string {
+	return strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+}
+
+// parseIBCForward parses the IBC forward flags and returns nil if no forward
+// channel is given.
+func parseIBCForward(fs *flag.FlagSet) (*types.IBCForward, error) {
+	channelId, _ := fs.GetString(FlagForwardChannel)
+	receiver, _ := fs.GetString(FlagForwardReceiver)
+	timeout, _ := fs.GetDuration(FlagForwardTimeout)
+	if channelId == "" {
+		if receiver != "" {
+			return nil, fmt.Errorf("--%s must be specified with --%s", FlagForwardChannel, FlagForwardReceiver)
+		}
+		return nil, nil
+	}
+	fwd := &types.IBCForward{
+		ChannelId: channelId,
+		Receiver:  receiver,
+		Timeout:   timeout,
+	}
+	if err := fwd.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid forward: %w", err)
+	}
+	return fwd, nil
+}
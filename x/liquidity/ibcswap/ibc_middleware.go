@@ -0,0 +1,275 @@
+// Package ibcswap implements an IBC middleware for the transfer module, which
+// makes orders with coins received through ICS-20 transfers.
+package ibcswap
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"shogun/x/liquidity/amm"
+	"shogun/x/liquidity/keeper"
+	"shogun/x/liquidity/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the transfer IBC module.
+// When an ICS-20 transfer with a swap memo is received, it makes an order
+// with the received coin on behalf of the receiver, after the underlying
+// module has received the coin.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware returns a new IBCMiddleware wrapping the transfer module.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// If the transfer has a swap memo, an order is made with the received coin
+// after the underlying module has received it.
+// When the order couldn't be made, the received coin is either kept by the
+// receiver or refunded to the sender depending on the fallback.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	swapMemo, found, memoErr := ParseMemo(data.Memo)
+	if !found {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	receivedCoin, err := ReceivedCoin(packet, data)
+	if err != nil { // shouldn't happen, since the coin has been received
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	fallback := DefaultFallback
+	err = memoErr
+	if err == nil {
+		err = swapMemo.Validate()
+	}
+	if err == nil {
+		if swapMemo.Fallback != "" {
+			fallback = swapMemo.Fallback
+		}
+		err = im.makeOrder(ctx, data.Receiver, receivedCoin, swapMemo)
+	}
+	if err == nil {
+		return ack
+	}
+
+	refunded := fallback == FallbackRefund
+	// The event is emitted even if the transfer fails.
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIBCSwapFailed{
+		Receiver:     data.Receiver,
+		PairId:       swapMemo.PairId,
+		ReceivedCoin: receivedCoin,
+		Error:        err.Error(),
+		Refunded:     refunded,
+	}); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if refunded {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return ack
+}
+
+// makeOrder makes an order with the received coin on behalf of the receiver.
+// No state is changed if it fails.
+func (im IBCMiddleware) makeOrder(ctx sdk.Context, receiver string, offerCoin sdk.Coin, swapMemo SwapMemo) error {
+	if swapMemo.Receiver != "" && swapMemo.Receiver != receiver {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidSwapMemo, "receiver %s doesn't match the transfer receiver %s", swapMemo.Receiver, receiver)
+	}
+	orderer, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return err
+	}
+	orderLifespan, err := swapMemo.orderLifespan()
+	if err != nil {
+		return err
+	}
+
+	pair, found := im.keeper.GetPair(ctx, swapMemo.PairId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", swapMemo.PairId)
+	}
+	var dir types.OrderDirection
+	var demandCoinDenom string
+	switch offerCoin.Denom {
+	case pair.QuoteCoinDenom:
+		dir, demandCoinDenom = types.OrderDirectionBuy, pair.BaseCoinDenom
+	case pair.BaseCoinDenom:
+		dir, demandCoinDenom = types.OrderDirectionSell, pair.QuoteCoinDenom
+	default:
+		return sdkerrors.Wrapf(types.ErrWrongPair, "%s is not in pair %d", offerCoin.Denom, pair.Id)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if swapMemo.MinOut == nil {
+		if pair.LastPrice == nil {
+			return types.ErrNoLastPrice
+		}
+		lowest, highest := im.keeper.PriceLimits(ctx, *pair.LastPrice)
+		price := highest
+		if dir == types.OrderDirectionSell {
+			price = lowest
+		}
+		msg := types.NewMsgMarketOrder(
+			orderer, pair.Id, dir, offerCoin, demandCoinDenom, orderAmount(dir, offerCoin.Amount, price), orderLifespan)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, err := im.keeper.MarketOrder(cacheCtx, msg); err != nil {
+			return err
+		}
+	} else {
+		tickPrec := int(im.keeper.GetTickPrecision(ctx))
+		var price math.LegacyDec
+		switch dir {
+		case types.OrderDirectionBuy:
+			price = math.LegacyNewDecFromInt(offerCoin.Amount).QuoInt(*swapMemo.MinOut)
+			if !price.IsPositive() {
+				return sdkerrors.Wrapf(types.ErrInvalidSwapMemo, "min out %s is too large", swapMemo.MinOut)
+			}
+			price = amm.PriceToDownTick(price, tickPrec)
+		case types.OrderDirectionSell:
+			price = amm.PriceToUpTick(math.LegacyNewDecFromInt(*swapMemo.MinOut).QuoInt(offerCoin.Amount), tickPrec)
+		}
+		msg := types.NewMsgLimitOrder(
+			orderer, pair.Id, dir, offerCoin, demandCoinDenom, price, orderAmount(dir, offerCoin.Amount, price), orderLifespan)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, err := im.keeper.LimitOrder(cacheCtx, msg); err != nil {
+			return err
+		}
+	}
+	writeCache()
+	return nil
+}
+
+// orderAmount returns the amount of base coin to order with the offer coin
+// at the price.
+func orderAmount(dir types.OrderDirection, offerAmt math.Int, price math.LegacyDec) math.Int {
+	if dir == types.OrderDirectionBuy {
+		return math.LegacyNewDecFromInt(offerAmt).QuoTruncate(price).TruncateInt()
+	}
+	return offerAmt
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// ReceivedCoin returns the coin received on this chain through the ICS-20
+// transfer, in the same way as the transfer module.
+func ReceivedCoin(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) (sdk.Coin, error) {
+	amt, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount: %s", data.Amount)
+	}
+
+	var denom string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// The coin is returning to this chain, so remove the prefix added by
+		// the sender chain.
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denom = data.Denom[len(voucherPrefix):]
+		if denomTrace := transfertypes.ParseDenomTrace(denom); denomTrace.Path != "" {
+			denom = denomTrace.IBCDenom()
+		}
+	} else {
+		prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+		denom = transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+	}
+	return sdk.NewCoin(denom, amt), nil
+}
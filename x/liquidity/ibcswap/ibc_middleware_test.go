@@ -0,0 +1,208 @@
+package ibcswap_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	chain "shogun/app"
+	utils "shogun/types"
+	"shogun/x/liquidity/types"
+)
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encCfg := chain.MakeEncodingConfig()
+	app := chain.New(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, chain.DefaultNodeHome,
+		5, encCfg, simapp.EmptyAppOptions{})
+	return app, chain.NewDefaultGenesisState(encCfg.Marshaler)
+}
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	// chainA is the chain receiving transfers with swap memos from chainB.
+	chainA, chainB *ibctesting.TestChain
+	path           *ibctesting.Path
+
+	// voucherDenom is the denom of chainB's stake on chainA.
+	voucherDenom string
+	// sellPair has the voucher as the base coin, and buyPair has it as the
+	// quote coin.
+	sellPair, buyPair types.Pair
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (s *MiddlewareTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	s.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	s.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	s.coordinator.Setup(s.path)
+
+	s.voucherDenom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	s.sellPair = s.createPairWithPool(s.voucherDenom, sdk.DefaultBondDenom)
+	s.buyPair = s.createPairWithPool(sdk.DefaultBondDenom, s.voucherDenom)
+	s.coordinator.CommitBlock(s.chainA)
+}
+
+func (s *MiddlewareTestSuite) appA() *chain.App {
+	return s.chainA.App.(*chain.App)
+}
+
+// createPairWithPool creates a pair with a pool at price 1 on chainA.
+func (s *MiddlewareTestSuite) createPairWithPool(baseDenom, quoteDenom string) types.Pair {
+	app, ctx := s.appA(), s.chainA.GetContext()
+	k := app.LiquidityKeeper
+	creator := s.chainA.SenderAccount.GetAddress()
+	depositCoins := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1_000_000_000), sdk.NewInt64Coin(quoteDenom, 1_000_000_000))
+	s.Require().NoError(chain.FundAccount(app.BankKeeper, ctx, creator, depositCoins))
+
+	pair, err := k.CreatePair(ctx, types.NewMsgCreatePair(creator, baseDenom, quoteDenom))
+	s.Require().NoError(err)
+	_, err = k.CreatePool(ctx, types.NewMsgCreatePool(creator, pair.Id, depositCoins))
+	s.Require().NoError(err)
+	lastPrice := utils.ParseDec("1.0")
+	pair.LastPrice = &lastPrice
+	k.SetPair(ctx, pair)
+	return pair
+}
+
+// transfer sends stake from chainB to the receiver on chainA with the memo,
+// and returns the acknowledgement and the events of receiving it on chainA.
+func (s *MiddlewareTestSuite) transfer(receiver sdk.AccAddress, amt int64, memo string) (channeltypes.Acknowledgement, sdk.Events) {
+	msg := transfertypes.NewMsgTransfer(
+		s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, amt), s.chainB.SenderAccount.GetAddress().String(), receiver.String(),
+		clienttypes.NewHeight(1, 110), 0, memo)
+	res, err := s.chainB.SendMsgs(msg)
+	s.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	res, err = s.path.EndpointA.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+	bz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	s.Require().NoError(s.path.EndpointB.AcknowledgePacket(packet, bz))
+
+	var ack channeltypes.Acknowledgement
+	s.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+	return ack, res.GetEvents()
+}
+
+func (s *MiddlewareTestSuite) balanceA(addr sdk.AccAddress, denom string) math.Int {
+	return s.appA().BankKeeper.GetBalance(s.chainA.GetContext(), addr, denom).Amount
+}
+
+func swapMemo(m string) string {
+	return fmt.Sprintf(`{"liquidity":%s}`, m)
+}
+
+func (s *MiddlewareTestSuite) TestSwapOnReceive() {
+	for _, tc := range []struct {
+		name        string
+		pairId      func() uint64
+		minOut      string
+		minReceived int64
+	}{
+		{"sell limit order", func() uint64 { return s.sellPair.Id }, `"95000"`, 95000},
+		{"buy limit order", func() uint64 { return s.buyPair.Id }, `"95000"`, 95000},
+		{"sell market order", func() uint64 { return s.sellPair.Id }, "", 95000},
+		// A market buy order's amount is determined by the highest price
+		// allowed, which is 10% above the last price.
+		{"buy market order", func() uint64 { return s.buyPair.Id }, "", 90000},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			receiver := s.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			memo := fmt.Sprintf(`{"pair_id":%d,"receiver":%q`, tc.pairId(), receiver.String())
+			if tc.minOut != "" {
+				memo += fmt.Sprintf(`,"min_out":%s`, tc.minOut)
+			}
+			memo += "}"
+			// Both pairs demand stake for the voucher.
+			before := s.balanceA(receiver, sdk.DefaultBondDenom)
+
+			ack, _ := s.transfer(receiver, 100000, swapMemo(memo))
+			s.Require().True(ack.Success(), ack.GetError())
+
+			// The order has been matched at the end of the block in which
+			// the transfer has been received.
+			received := s.balanceA(receiver, sdk.DefaultBondDenom).Sub(before)
+			s.Require().True(received.GTE(math.NewInt(tc.minReceived)), received.String())
+			s.Require().Empty(s.appA().LiquidityKeeper.GetAllOrders(s.chainA.GetContext()))
+		})
+	}
+}
+
+func (s *MiddlewareTestSuite) TestFallback() {
+	failedEvent := proto.MessageName(&types.EventIBCSwapFailed{})
+	hasFailedEvent := func(events sdk.Events) bool {
+		for _, event := range events {
+			if event.Type == failedEvent {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, tc := range []struct {
+		name     string
+		memo     func() string
+		refunded bool
+		failed   bool
+	}{
+		{"no memo", func() string { return "" }, false, false},
+		{"memo for another middleware", func() string { return `{"forward":{"receiver":"addr"}}` }, false, false},
+		{"pair not found", func() string { return swapMemo(`{"pair_id":100}`) }, false, true},
+		{"pair not found with refund", func() string {
+			return swapMemo(`{"pair_id":100,"fallback":"refund"}`)
+		}, true, true},
+		{"invalid memo", func() string { return swapMemo(`{"pair_id":"one"}`) }, false, true},
+		{"receiver mismatch", func() string {
+			return swapMemo(fmt.Sprintf(
+				`{"pair_id":%d,"receiver":%q,"fallback":"refund"}`, s.sellPair.Id, s.chainA.SenderAccount.GetAddress().String()))
+		}, true, true},
+		{"too large min out", func() string {
+			return swapMemo(fmt.Sprintf(`{"pair_id":%d,"min_out":"1000000","fallback":"refund"}`, s.sellPair.Id))
+		}, true, true},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			receiver := s.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			ack, events := s.transfer(receiver, 100000, tc.memo())
+			s.Require().Equal(!tc.refunded, ack.Success())
+			s.Require().Equal(tc.failed, hasFailedEvent(events))
+			if tc.refunded {
+				s.Require().True(s.balanceA(receiver, s.voucherDenom).IsZero())
+			} else {
+				s.Require().Equal(math.NewInt(100000), s.balanceA(receiver, s.voucherDenom))
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package ibcswap
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"shogun/x/liquidity/types"
+)
+
+// MemoKey is the key of the swap memo in an ICS-20 transfer memo.
+const MemoKey = "liquidity"
+
+// Fallback specifies what happens to the received coin when an order
+// couldn't be made with it.
+type Fallback string
+
+const (
+	// FallbackKeep lets the receiver keep the received coin.
+	FallbackKeep Fallback = "keep"
+	// FallbackRefund fails the transfer, so the coin is refunded to the
+	// sender on the source chain.
+	FallbackRefund Fallback = "refund"
+
+	// DefaultFallback is the fallback of swap memos which don't specify
+	// their fallback, including invalid ones.
+	// It's the same on every node, since the fallback decides whether the
+	// transfer succeeds.
+	DefaultFallback = FallbackKeep
+)
+
+// Validate validates the fallback.
+func (f Fallback) Validate() error {
+	switch f {
+	case FallbackKeep, FallbackRefund:
+		return nil
+	default:
+		return fmt.Errorf("unknown fallback: %q", f)
+	}
+}
+
+// SwapMemo specifies an order to make with the coin received through an
+// ICS-20 transfer, on behalf of the receiver.
+// It is set under MemoKey in the transfer memo, like:
+//
+//	{"liquidity":{"pair_id":1,"min_out":"1000000","receiver":"cosmos1..."}}
+type SwapMemo struct {
+	PairId uint64 `json:"pair_id"`
+	// MinOut makes a limit order which demands at least MinOut for the
+	// received coin, if set. Otherwise, a market order is made.
+	MinOut *math.Int `json:"min_out,omitempty"`
+	// Receiver must be the same as the receiver of the transfer, if set.
+	Receiver string `json:"receiver,omitempty"`
+	// OrderLifespan is the lifespan of the order in Go duration format.
+	// The order lives only for the current batch if empty.
+	OrderLifespan string `json:"order_lifespan,omitempty"`
+	// Fallback overrides the default fallback of the middleware, if set.
+	Fallback Fallback `json:"fallback,omitempty"`
+}
+
+// ParseMemo parses the swap memo from an ICS-20 transfer memo.
+// It returns false if the transfer memo doesn't have a swap memo in it.
+func ParseMemo(memo string) (swapMemo SwapMemo, found bool, err error) {
+	var m map[string]json.RawMessage
+	// A memo which isn't a JSON object isn't meant for this middleware.
+	if err := json.Unmarshal([]byte(memo), &m); err != nil {
+		return SwapMemo{}, false, nil
+	}
+	bz, ok := m[MemoKey]
+	if !ok {
+		return SwapMemo{}, false, nil
+	}
+	if err := json.Unmarshal(bz, &swapMemo); err != nil {
+		return SwapMemo{}, true, sdkerrors.Wrap(types.ErrInvalidSwapMemo, err.Error())
+	}
+	return swapMemo, true, nil
+}
+
+// Validate validates the swap memo statelessly.
+func (m SwapMemo) Validate() error {
+	if m.PairId == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidSwapMemo, "pair id must not be 0")
+	}
+	if m.MinOut != nil && (m.MinOut.IsNil() || !m.MinOut.IsPositive()) {
+		return sdkerrors.Wrapf(types.ErrInvalidSwapMemo, "min out must be positive: %s", m.MinOut)
+	}
+	if _, err := m.orderLifespan(); err != nil {
+		return err
+	}
+	if m.Fallback != "" {
+		if err := m.Fallback.Validate(); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidSwapMemo, err.Error())
+		}
+	}
+	return nil
+}
+
+func (m SwapMemo) orderLifespan() (time.Duration, error) {
+	if m.OrderLifespan == "" {
+		return 0, nil
+	}
+	lifespan, err := time.ParseDuration(m.OrderLifespan)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSwapMemo, "invalid order lifespan: %v", err)
+	}
+	if lifespan < 0 {
+		return 0, sdkerrors.Wrap(types.ErrInvalidSwapMemo, "order lifespan must not be negative")
+	}
+	return lifespan, nil
+}
@@ -0,0 +1,84 @@
+package ibcswap_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"shogun/x/liquidity/ibcswap"
+)
+
+func TestParseMemo(t *testing.T) {
+	minOut := math.NewInt(1000000)
+	for _, tc := range []struct {
+		name     string
+		memo     string
+		expected ibcswap.SwapMemo
+		found    bool
+		expErr   string
+	}{
+		{"empty memo", "", ibcswap.SwapMemo{}, false, ""},
+		{"plain text memo", "hello", ibcswap.SwapMemo{}, false, ""},
+		{"other key", `{"forward":{}}`, ibcswap.SwapMemo{}, false, ""},
+		{
+			"market order",
+			`{"liquidity":{"pair_id":1}}`,
+			ibcswap.SwapMemo{PairId: 1},
+			true, "",
+		},
+		{
+			"limit order",
+			`{"liquidity":{"pair_id":1,"min_out":"1000000","receiver":"cosmos1","order_lifespan":"1h","fallback":"refund"}}`,
+			ibcswap.SwapMemo{
+				PairId: 1, MinOut: &minOut, Receiver: "cosmos1", OrderLifespan: "1h", Fallback: ibcswap.FallbackRefund,
+			},
+			true, "",
+		},
+		{"invalid swap memo", `{"liquidity":[]}`, ibcswap.SwapMemo{}, true, "invalid swap memo"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			swapMemo, found, err := ibcswap.ParseMemo(tc.memo)
+			require.Equal(t, tc.found, found)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, swapMemo)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestSwapMemo_Validate(t *testing.T) {
+	zero, minOut := math.ZeroInt(), math.NewInt(1000000)
+	for _, tc := range []struct {
+		name     string
+		malleate func(m *ibcswap.SwapMemo)
+		expErr   string
+	}{
+		{"valid", func(m *ibcswap.SwapMemo) {}, ""},
+		{"zero pair id", func(m *ibcswap.SwapMemo) { m.PairId = 0 }, "pair id must not be 0: invalid swap memo"},
+		{"zero min out", func(m *ibcswap.SwapMemo) { m.MinOut = &zero }, "min out must be positive: 0: invalid swap memo"},
+		{
+			"invalid order lifespan", func(m *ibcswap.SwapMemo) { m.OrderLifespan = "1 day" },
+			`invalid order lifespan: time: unknown unit " day" in duration "1 day": invalid swap memo`,
+		},
+		{
+			"negative order lifespan", func(m *ibcswap.SwapMemo) { m.OrderLifespan = "-1h" },
+			"order lifespan must not be negative: invalid swap memo",
+		},
+		{"unknown fallback", func(m *ibcswap.SwapMemo) { m.Fallback = "burn" }, `unknown fallback: "burn": invalid swap memo`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m := ibcswap.SwapMemo{PairId: 1, MinOut: &minOut, OrderLifespan: "1h", Fallback: ibcswap.FallbackKeep}
+			tc.malleate(&m)
+			err := m.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expErr)
+			}
+		})
+	}
+}
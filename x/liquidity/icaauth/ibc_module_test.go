@@ -0,0 +1,229 @@
+package icaauth_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	chain "shogun/app"
+	utils "shogun/types"
+	"shogun/x/liquidity/keeper"
+	"shogun/x/liquidity/types"
+)
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encCfg := chain.MakeEncodingConfig()
+	app := chain.New(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, chain.DefaultNodeHome,
+		5, encCfg, simapp.EmptyAppOptions{})
+	return app, chain.NewDefaultGenesisState(encCfg.Marshaler)
+}
+
+type ICAAuthTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	// chainA is the controller chain making MM orders on chainB through the
+	// owner's interchain account.
+	chainA, chainB *ibctesting.TestChain
+	path           *ibctesting.Path
+
+	owner sdk.AccAddress
+	ica   sdk.AccAddress
+	// pair is the pair on chainB to make MM orders in.
+	pair types.Pair
+}
+
+func TestICAAuthTestSuite(t *testing.T) {
+	suite.Run(t, new(ICAAuthTestSuite))
+}
+
+func (s *ICAAuthTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	s.coordinator.SetupConnections(s.path)
+	s.owner = s.chainA.SenderAccount.GetAddress()
+	s.registerICA()
+
+	s.pair = s.createPairWithPool("denom1", "denom2")
+	s.Require().NoError(chain.FundAccount(
+		s.appB().BankKeeper, s.chainB.GetContext(), s.ica,
+		sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000), sdk.NewInt64Coin("denom2", 1_000_000))))
+	s.coordinator.CommitBlock(s.chainB)
+}
+
+func (s *ICAAuthTestSuite) appA() *chain.App {
+	return s.chainA.App.(*chain.App)
+}
+
+func (s *ICAAuthTestSuite) appB() *chain.App {
+	return s.chainB.App.(*chain.App)
+}
+
+// registerICA registers the owner's interchain account on chainB and
+// completes the channel handshake.
+func (s *ICAAuthTestSuite) registerICA() {
+	res, err := s.chainA.SendMsgs(types.NewMsgRegisterICA(s.owner, s.path.EndpointA.ConnectionID, ""))
+	s.Require().NoError(err)
+	channelId, err := ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	portId, err := icatypes.NewControllerPortID(s.owner.String())
+	s.Require().NoError(err)
+	version := icatypes.NewDefaultMetadataString(s.path.EndpointA.ConnectionID, s.path.EndpointB.ConnectionID)
+	s.path.EndpointA.ChannelID = channelId
+	s.path.EndpointA.ChannelConfig.PortID = portId
+	s.path.EndpointA.ChannelConfig.Version = version
+	s.path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	s.path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	s.path.EndpointB.ChannelConfig.Version = version
+	s.path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	s.Require().NoError(s.path.EndpointB.ChanOpenTry())
+	s.Require().NoError(s.path.EndpointA.ChanOpenAck())
+	s.Require().NoError(s.path.EndpointB.ChanOpenConfirm())
+
+	ica, found := s.appA().ICAControllerKeeper.GetInterchainAccountAddress(
+		s.chainA.GetContext(), s.path.EndpointA.ConnectionID, portId)
+	s.Require().True(found)
+	s.ica, err = sdk.AccAddressFromBech32(ica)
+	s.Require().NoError(err)
+}
+
+// createPairWithPool creates a pair with a pool at price 1 on chainB.
+func (s *ICAAuthTestSuite) createPairWithPool(baseDenom, quoteDenom string) types.Pair {
+	app, ctx := s.appB(), s.chainB.GetContext()
+	k := app.LiquidityKeeper
+	creator := s.chainB.SenderAccount.GetAddress()
+	depositCoins := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1_000_000_000), sdk.NewInt64Coin(quoteDenom, 1_000_000_000))
+	s.Require().NoError(chain.FundAccount(app.BankKeeper, ctx, creator, depositCoins))
+
+	pair, err := k.CreatePair(ctx, types.NewMsgCreatePair(creator, baseDenom, quoteDenom))
+	s.Require().NoError(err)
+	_, err = k.CreatePool(ctx, types.NewMsgCreatePool(creator, pair.Id, depositCoins))
+	s.Require().NoError(err)
+	lastPrice := utils.ParseDec("1.0")
+	pair.LastPrice = &lastPrice
+	k.SetPair(ctx, pair)
+	return pair
+}
+
+// send sends the msg on chainA and returns the ICA packet sent.
+func (s *ICAAuthTestSuite) send(msg sdk.Msg) channeltypes.Packet {
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	req, found := s.appA().LiquidityKeeper.GetICARequest(s.chainA.GetContext(), packet.SourceChannel, packet.Sequence)
+	s.Require().True(found)
+	s.Require().Equal(types.ICARequestStatusPending, req.Status)
+	return packet
+}
+
+func (s *ICAAuthTestSuite) icaRequest(packet channeltypes.Packet) types.ICARequest {
+	querier := keeper.Querier{Keeper: s.appA().LiquidityKeeper}
+	resp, err := querier.ICARequest(
+		sdk.WrapSDKContext(s.chainA.GetContext()),
+		&types.QueryICARequestRequest{ChannelId: packet.SourceChannel, Sequence: packet.Sequence})
+	s.Require().NoError(err)
+	return resp.Request
+}
+
+func (s *ICAAuthTestSuite) mmOrderMsg(pairId uint64, timeout time.Duration) *types.MsgICAMMOrder {
+	return types.NewMsgICAMMOrder(
+		s.owner, s.path.EndpointA.ConnectionID, pairId,
+		utils.ParseDec("1.1"), utils.ParseDec("1.05"), math.NewInt(100000),
+		utils.ParseDec("0.95"), utils.ParseDec("0.9"), math.NewInt(100000),
+		time.Hour, timeout)
+}
+
+func (s *ICAAuthTestSuite) TestMMOrder() {
+	packet := s.send(s.mmOrderMsg(s.pair.Id, 10*time.Minute))
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	req := s.icaRequest(packet)
+	s.Require().Equal(types.ICARequestStatusSucceeded, req.Status)
+	s.Require().Equal(s.owner.String(), req.Owner)
+	s.Require().Equal(s.pair.Id, req.PairId)
+	s.Require().NotEmpty(req.OrderIds)
+	for _, orderId := range req.OrderIds {
+		order, found := s.appB().LiquidityKeeper.GetOrder(s.chainB.GetContext(), s.pair.Id, orderId)
+		s.Require().True(found)
+		s.Require().Equal(s.ica.String(), order.Orderer)
+		s.Require().Equal(types.OrderTypeMM, order.Type)
+	}
+
+	packet = s.send(types.NewMsgICACancelMMOrder(s.owner, s.path.EndpointA.ConnectionID, s.pair.Id, 10*time.Minute))
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	cancelReq := s.icaRequest(packet)
+	s.Require().Equal(types.ICARequestStatusSucceeded, cancelReq.Status)
+	s.Require().ElementsMatch(req.OrderIds, cancelReq.OrderIds)
+}
+
+func (s *ICAAuthTestSuite) TestMMOrderFailed() {
+	packet := s.send(s.mmOrderMsg(100, 10*time.Minute))
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	req := s.icaRequest(packet)
+	s.Require().Equal(types.ICARequestStatusFailed, req.Status)
+	s.Require().NotEmpty(req.Error)
+	s.Require().Empty(req.OrderIds)
+}
+
+func (s *ICAAuthTestSuite) TestMMOrderUndecodableAck() {
+	packet := s.send(s.mmOrderMsg(s.pair.Id, 10*time.Minute))
+
+	ack := channeltypes.NewResultAcknowledgement([]byte("invalid"))
+	s.Require().NoError(s.appA().LiquidityKeeper.OnICAAcknowledgementPacket(
+		s.chainA.GetContext(), packet, ack.Acknowledgement()))
+
+	req := s.icaRequest(packet)
+	s.Require().Equal(types.ICARequestStatusFailed, req.Status)
+	s.Require().NotEmpty(req.Error)
+	s.Require().Empty(req.OrderIds)
+}
+
+func (s *ICAAuthTestSuite) TestMMOrderTimedOut() {
+	packet := s.send(s.mmOrderMsg(s.pair.Id, time.Second))
+
+	s.coordinator.IncrementTimeBy(time.Minute)
+	s.coordinator.CommitBlock(s.chainB)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	// Endpoint.TimeoutPacket looks up the next receive sequence with the
+	// controller's port, so the timeout is made here.
+	proof, proofHeight := s.path.EndpointB.QueryProof(
+		host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel()))
+	nextSeqRecv, found := s.appB().IBCKeeper.ChannelKeeper.GetNextSequenceRecv(
+		s.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	s.Require().True(found)
+	_, err := s.chainA.SendMsgs(channeltypes.NewMsgTimeout(
+		packet, nextSeqRecv, proof, proofHeight, s.chainA.SenderAccount.GetAddress().String()))
+	s.Require().NoError(err)
+
+	s.Require().Equal(types.ICARequestStatusTimedOut, s.icaRequest(packet).Status)
+	s.Require().Empty(s.appB().LiquidityKeeper.GetAllOrders(s.chainB.GetContext()))
+}
+
+func (s *ICAAuthTestSuite) TestICANotFound() {
+	owner := s.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	_, err := s.appA().LiquidityKeeper.ICACancelMMOrder(
+		s.chainA.GetContext(),
+		types.NewMsgICACancelMMOrder(owner, s.path.EndpointA.ConnectionID, s.pair.Id, time.Minute))
+	s.Require().ErrorIs(err, types.ErrICANotFound)
+}
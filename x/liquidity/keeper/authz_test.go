@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	utils "shogun/types"
+	"shogun/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestTradingAuthorization() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+
+	granter, grantee := s.addr(1), s.addr(2)
+	s.fundAddr(granter, utils.ParseCoins("1000000denom1,1000000denom2"))
+	msgTypeURL := sdk.MsgTypeURL(&types.MsgLimitOrder{})
+	expiration := s.ctx.BlockTime().Add(24 * time.Hour)
+	s.Require().NoError(s.app.AuthzKeeper.SaveGrant(s.ctx, grantee, granter, types.NewTradingAuthorization(
+		msgTypeURL, []uint64{pair.Id}, []types.OrderDirection{types.OrderDirectionSell},
+		utils.ParseCoins("10000denom1"), time.Hour), &expiration))
+
+	sellOrder := func(pairId uint64, offerCoin sdk.Coin, demandCoinDenom string) error {
+		msg := types.NewMsgLimitOrder(
+			granter, pairId, types.OrderDirectionSell, offerCoin, demandCoinDenom,
+			utils.ParseDec("1.0"), offerCoin.Amount, 0)
+		s.Require().NoError(msg.ValidateBasic())
+		_, err := s.app.AuthzKeeper.DispatchActions(s.ctx, grantee, []sdk.Msg{msg})
+		return err
+	}
+
+	s.Require().NoError(sellOrder(pair.Id, utils.ParseCoin("6000denom1"), "denom2"))
+	s.Require().True(s.getBalance(pair.GetEscrowAddress(), "denom1").Amount.IsPositive())
+
+	auth, _ := s.app.AuthzKeeper.GetAuthorization(s.ctx, grantee, granter, msgTypeURL)
+	tradingAuth := auth.(*types.TradingAuthorization)
+	s.Require().Equal(utils.ParseCoins("6000denom1"), tradingAuth.PeriodSpent)
+	s.Require().Equal(s.ctx.BlockTime().Add(time.Hour), tradingAuth.PeriodReset)
+
+	// The cumulative offer amount exceeds the spend limit.
+	s.Require().ErrorIs(sellOrder(pair.Id, utils.ParseCoin("5000denom1"), "denom2"), sdkerrors.ErrInsufficientFunds)
+	// The pair isn't allowed.
+	s.Require().ErrorIs(sellOrder(pair2.Id, utils.ParseCoin("1000denom2"), "denom3"), sdkerrors.ErrUnauthorized)
+	// The direction isn't allowed.
+	buyMsg := types.NewMsgLimitOrder(
+		granter, pair.Id, types.OrderDirectionBuy, utils.ParseCoin("1000denom2"), "denom1",
+		utils.ParseDec("1.0"), sdk.NewInt(1000), 0)
+	_, err := s.app.AuthzKeeper.DispatchActions(s.ctx, grantee, []sdk.Msg{buyMsg})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	// Received coins can't be forwarded.
+	forwardMsg := types.NewMsgLimitOrder(
+		granter, pair.Id, types.OrderDirectionSell, utils.ParseCoin("1000denom1"), "denom2",
+		utils.ParseDec("1.0"), sdk.NewInt(1000), 0)
+	forwardMsg.Forward = &types.IBCForward{ChannelId: "channel-0", Receiver: s.addr(3).String(), Timeout: time.Hour}
+	_, err = s.app.AuthzKeeper.DispatchActions(s.ctx, grantee, []sdk.Msg{forwardMsg})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	// Withdraws can't be made with the authorization.
+	withdrawMsg := types.NewMsgWithdraw(granter, 1, utils.ParseCoin("1000pool1"))
+	_, err = s.app.AuthzKeeper.DispatchActions(s.ctx, grantee, []sdk.Msg{withdrawMsg})
+	s.Require().ErrorIs(err, authz.ErrNoAuthorizationFound)
+
+	// The spent amount is reset in the next period.
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(sellOrder(pair.Id, utils.ParseCoin("5000denom1"), "denom2"))
+	auth, _ = s.app.AuthzKeeper.GetAuthorization(s.ctx, grantee, granter, msgTypeURL)
+	s.Require().Equal(utils.ParseCoins("5000denom1"), auth.(*types.TradingAuthorization).PeriodSpent)
+}
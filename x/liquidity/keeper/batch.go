@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"shogun/x/liquidity/types"
@@ -9,28 +11,17 @@ import (
 // ExecuteRequests executes all orders, deposit requests and withdraw requests.
 // ExecuteRequests also handles order expiration.
 func (k Keeper) ExecuteRequests(ctx sdk.Context) {
-	if err := k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
-		if err := k.ExecuteMatching(ctx, pair); err != nil {
-			return false, err
-		}
-		return false, nil
-	}); err != nil {
+	if err := k.ExecuteMatchingOnPairs(ctx, k.GetAllPairs(ctx)); err != nil {
 		panic(err)
 	}
-	if err := k.IterateAllOrders(ctx, func(order types.Order) (stop bool, err error) {
-		if order.Status.CanBeExpired() && order.ExpiredAt(ctx.BlockTime()) {
-			if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
-				return false, err
-			}
-		} else if types.IsTooSmallOrderAmount(order.OpenAmount, order.Price) {
-			// TODO: should we introduce new order status for this type of expiration?
+	// Collect expired orders first, since finishing an order modifies the
+	// order expiry index.
+	for _, order := range k.GetExpiredOrders(ctx, ctx.BlockTime()) {
+		if order.Status.CanBeExpired() {
 			if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
-				return false, err
+				panic(err)
 			}
 		}
-		return false, nil
-	}); err != nil {
-		panic(err)
 	}
 	if err := k.IterateAllDepositRequests(ctx, func(req types.DepositRequest) (stop bool, err error) {
 		if req.Status == types.RequestStatusNotExecuted {
@@ -55,24 +46,42 @@ func (k Keeper) ExecuteRequests(ctx sdk.Context) {
 }
 
 // DeleteOutdatedRequests deletes outdated(should be deleted) requests.
-// Determining if a request should be deleted is based on its status.
+// Requests and orders are queued for the deletion when they're finished,
+// so only the queued ones whose deletion height has been reached are deleted.
 func (k Keeper) DeleteOutdatedRequests(ctx sdk.Context) {
-	_ = k.IterateAllDepositRequests(ctx, func(req types.DepositRequest) (stop bool, err error) {
-		if req.Status.ShouldBeDeleted() {
-			k.DeleteDepositRequest(ctx, req)
-		}
+	// Collect keys first, since the deletion modifies the queue.
+	var keys [][]byte
+	collect := func(key []byte) (stop bool, err error) {
+		keys = append(keys, key)
 		return false, nil
-	})
-	_ = k.IterateAllWithdrawRequests(ctx, func(req types.WithdrawRequest) (stop bool, err error) {
-		if req.Status.ShouldBeDeleted() {
-			k.DeleteWithdrawRequest(ctx, req)
-		}
-		return false, nil
-	})
-	_ = k.IterateAllOrders(ctx, func(order types.Order) (stop bool, err error) {
-		if order.Status.ShouldBeDeleted() {
-			k.DeleteOrder(ctx, order)
+	}
+	_ = k.IterateDeletionQueueUntil(ctx, types.DepositRequestDeletionQueueKeyPrefix, ctx.BlockHeight(), collect)
+	_ = k.IterateDeletionQueueUntil(ctx, types.WithdrawRequestDeletionQueueKeyPrefix, ctx.BlockHeight(), collect)
+	_ = k.IterateDeletionQueueUntil(ctx, types.OrderDeletionQueueKeyPrefix, ctx.BlockHeight(), collect)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		_, id1, id2 := types.ParseDeletionQueueKey(key)
+		switch {
+		case bytes.HasPrefix(key, types.DepositRequestDeletionQueueKeyPrefix):
+			if req, found := k.GetDepositRequest(ctx, id1, id2); found {
+				k.DeleteDepositRequest(ctx, req)
+			}
+		case bytes.HasPrefix(key, types.WithdrawRequestDeletionQueueKeyPrefix):
+			if req, found := k.GetWithdrawRequest(ctx, id1, id2); found {
+				k.DeleteWithdrawRequest(ctx, req)
+			}
+		case bytes.HasPrefix(key, types.OrderDeletionQueueKeyPrefix):
+			if order, found := k.GetOrder(ctx, id1, id2); found {
+				k.DeleteOrder(ctx, order)
+			}
 		}
-		return false, nil
-	})
+		store.Delete(key)
+	}
+}
+
+// deletionHeight returns the block height at which requests and orders
+// finished in the current block should be deleted.
+func (k Keeper) deletionHeight(ctx sdk.Context) int64 {
+	return ctx.BlockHeight() + 1 + int64(k.GetFinishedRecordRetentionBlocks(ctx))
 }
@@ -1,38 +1,265 @@
 package keeper_test
 
 import (
-	_ "github.com/stretchr/testify/suite"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "shogun/types"
+	"shogun/x/liquidity"
+	"shogun/x/liquidity/types"
 )
 
-// func (s *KeeperTestSuite) TestOrderExpiration() {
-// 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
-
-// 	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:00Z"))
-// 	order := s.limitOrder(s.addr(1), pair.Id, types.OrderDirectionSell, utils.ParseDec("1.0"), math.NewInt(10000), 10*time.Second, true)
-// 	liquidity.EndBlocker(s.ctx, s.keeper)
-
-// 	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:06Z"))
-// 	liquidity.BeginBlocker(s.ctx, s.keeper)
-// 	order, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
-// 	s.Require().True(found) // The order is not yet deleted.
-// 	// A buy order comes in.
-// 	s.limitOrder(s.addr(2), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), math.NewInt(5000), 0, true)
-// 	liquidity.EndBlocker(s.ctx, s.keeper)
-
-// 	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:12Z"))
-// 	liquidity.BeginBlocker(s.ctx, s.keeper)
-// 	order, found = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
-// 	s.Require().True(found)
-// 	s.Require().Equal(types.OrderStatusPartiallyMatched, order.Status)
-// 	// Another buy order comes in, but this time the first order has been expired,
-// 	// so there is no match.
-// 	s.limitOrder(s.addr(3), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), math.NewInt(5000), 0, true)
-// 	liquidity.EndBlocker(s.ctx, s.keeper)
-// 	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
-// 	s.Require().Equal(types.OrderStatusExpired, order.Status)
-// 	s.Require().True(intEq(math.NewInt(5000), order.OpenAmount))
-
-// 	liquidity.BeginBlocker(s.ctx, s.keeper)
-// 	_, found = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
-// 	s.Require().False(found) // The order is gone.
-// }
+func (s *KeeperTestSuite) TestOrderExpiration() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:00Z"))
+	order := s.limitOrder(s.addr(1), pair.Id, types.OrderDirectionSell, utils.ParseDec("1.0"), math.NewInt(10000), 10*time.Second, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(utils.ParseTime("2022-03-01T12:00:06Z"))
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	order, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().True(found) // The order is not yet deleted.
+	// A buy order comes in.
+	s.limitOrder(s.addr(2), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), math.NewInt(5000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(utils.ParseTime("2022-03-01T12:00:12Z"))
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	order, found = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusPartiallyMatched, order.Status)
+	// Another buy order comes in, but this time the first order has been expired,
+	// so there is no match.
+	s.limitOrder(s.addr(3), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), math.NewInt(5000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().Equal(types.OrderStatusExpired, order.Status)
+	s.Require().True(intEq(math.NewInt(5000), order.OpenAmount))
+
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	_, found = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().False(found) // The order is gone.
+}
+
+func (s *KeeperTestSuite) TestOrderExpiryIndex() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:00Z"))
+	order1 := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.1"), math.NewInt(10000), 10*time.Second, true)
+	order2 := s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.9"), math.NewInt(10000), 20*time.Second, true)
+	order3 := s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.2"), math.NewInt(10000), 30*time.Second, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	s.Require().Empty(s.keeper.GetExpiredOrders(s.ctx, utils.ParseTime("2022-03-01T12:00:09Z")))
+	orders := s.keeper.GetExpiredOrders(s.ctx, utils.ParseTime("2022-03-01T12:00:20Z"))
+	s.Require().Len(orders, 2)
+	s.Require().Equal(order1.Id, orders[0].Id)
+	s.Require().Equal(order2.Id, orders[1].Id)
+
+	// Canceled orders are removed from the index.
+	s.cancelOrder(s.addr(2), pair.Id, order2.Id)
+	orders = s.keeper.GetExpiredOrders(s.ctx, utils.ParseTime("2022-03-01T12:00:30Z"))
+	s.Require().Len(orders, 2)
+	s.Require().Equal(order1.Id, orders[0].Id)
+	s.Require().Equal(order3.Id, orders[1].Id)
+
+	// Expired orders are removed from the index, too.
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:10Z"))
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	order1, _ = s.keeper.GetOrder(s.ctx, pair.Id, order1.Id)
+	s.Require().Equal(types.OrderStatusExpired, order1.Status)
+	orders = s.keeper.GetExpiredOrders(s.ctx, utils.ParseTime("2022-03-01T12:00:30Z"))
+	s.Require().Len(orders, 1)
+	s.Require().Equal(order3.Id, orders[0].Id)
+
+	// Deleting an order removes it from the index.
+	order3, _ = s.keeper.GetOrder(s.ctx, pair.Id, order3.Id)
+	s.keeper.DeleteOrder(s.ctx, order3)
+	s.Require().Empty(s.keeper.GetExpiredOrders(s.ctx, utils.ParseTime("2022-03-01T12:00:30Z")))
+
+	// A dangling index entry is a broken invariant, not an empty result.
+	s.keeper.SetOrderExpiryIndex(s.ctx, order3)
+	s.Require().Panics(func() {
+		s.keeper.GetExpiredOrders(s.ctx, utils.ParseTime("2022-03-01T12:00:30Z"))
+	})
+}
+
+func (s *KeeperTestSuite) TestExpireSmallOrders() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.000018"), math.NewInt(10000000), time.Minute, true)
+	// This order has 10000 open amount after matching, which would receive
+	// floor(10000*0.000018) demand coin if matched again, which is zero.
+	// So the order expires in the same batch, without waiting for its
+	// expiry time.
+	order := s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.000018"), math.NewInt(10010000), time.Minute, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	order, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusExpired, order.Status)
+	s.Require().True(intEq(math.NewInt(10000), order.OpenAmount))
+	s.Require().Empty(s.keeper.GetExpiredOrders(s.ctx, order.ExpireAt))
+}
+
+func (s *KeeperTestSuite) TestFinishedRecordRetention() {
+	params := s.keeper.GetParams(s.ctx)
+	params.FinishedRecordRetentionBlocks = 2
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	s.ctx = s.ctx.WithBlockHeight(10)
+	order := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("2.0"), math.NewInt(10000), 0, true)
+	req := s.deposit(s.addr(2), pool.Id, utils.ParseCoins("10000denom1,10000denom2"), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().Equal(types.OrderStatusExpired, order.Status)
+	req, _ = s.keeper.GetDepositRequest(s.ctx, req.PoolId, req.Id)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+
+	for height := int64(11); height <= 12; height++ {
+		s.ctx = s.ctx.WithBlockHeight(height)
+		liquidity.BeginBlocker(s.ctx, s.keeper)
+		_, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+		s.Require().True(found)
+		_, found = s.keeper.GetDepositRequest(s.ctx, req.PoolId, req.Id)
+		s.Require().True(found)
+		liquidity.EndBlocker(s.ctx, s.keeper)
+	}
+
+	s.ctx = s.ctx.WithBlockHeight(13)
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	_, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().False(found)
+	_, found = s.keeper.GetDepositRequest(s.ctx, req.PoolId, req.Id)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestIterateOrdersByPrice() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	buy1 := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.9"), math.NewInt(10000), time.Hour, true)
+	buy2 := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.95"), math.NewInt(10000), time.Hour, true)
+	buy3 := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.8"), math.NewInt(10000), time.Hour, true)
+	sell1 := s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.1"), math.NewInt(10000), time.Hour, true)
+	sell2 := s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.05"), math.NewInt(10000), time.Hour, true)
+	sell3 := s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.2"), math.NewInt(10000), time.Hour, true)
+
+	orderIds := func(iterate func(ctx sdk.Context, pairId uint64, price math.LegacyDec, cb func(order types.Order) (stop bool, err error)) error, price math.LegacyDec) (ids []uint64) {
+		_ = iterate(s.ctx, pair.Id, price, func(order types.Order) (stop bool, err error) {
+			ids = append(ids, order.Id)
+			return false, nil
+		})
+		return
+	}
+
+	s.Require().Equal([]uint64{buy2.Id, buy1.Id}, orderIds(s.keeper.IterateBuyOrdersOver, utils.ParseDec("0.9")))
+	s.Require().Equal([]uint64{buy2.Id, buy1.Id, buy3.Id}, orderIds(s.keeper.IterateBuyOrdersOver, utils.ParseDec("0.1")))
+	s.Require().Empty(orderIds(s.keeper.IterateBuyOrdersOver, utils.ParseDec("0.96")))
+	s.Require().Equal([]uint64{sell2.Id, sell1.Id}, orderIds(s.keeper.IterateSellOrdersUnder, utils.ParseDec("1.1")))
+	s.Require().Equal([]uint64{sell2.Id, sell1.Id, sell3.Id}, orderIds(s.keeper.IterateSellOrdersUnder, utils.ParseDec("10")))
+	s.Require().Empty(orderIds(s.keeper.IterateSellOrdersUnder, utils.ParseDec("1.04")))
+
+	// Finished orders are not iterated.
+	s.nextBlock()
+	s.cancelOrder(s.addr(1), pair.Id, buy2.Id)
+	s.Require().Equal([]uint64{buy1.Id, buy3.Id}, orderIds(s.keeper.IterateBuyOrdersOver, utils.ParseDec("0.1")))
+
+	// A dangling index entry is a broken invariant, not a skipped order.
+	sell3, _ = s.keeper.GetOrder(s.ctx, pair.Id, sell3.Id)
+	s.keeper.DeleteOrder(s.ctx, sell3)
+	s.keeper.SetOrdersByPriceIndex(s.ctx, sell3)
+	s.Require().Panics(func() {
+		orderIds(s.keeper.IterateSellOrdersUnder, utils.ParseDec("10"))
+	})
+}
+
+func (s *KeeperTestSuite) TestMatchingWithOrdersOutOfPriceLimits() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	// This order is placed within the price limits, but it'll be out of
+	// the price limits after the last price changes.
+	staleOrder := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.05"), math.NewInt(10000), time.Hour, true)
+	s.nextBlock()
+
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	pair.LastPrice = utils.ParseDecP("0.5")
+	s.keeper.SetPair(s.ctx, pair)
+
+	buyOrder := s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.52"), math.NewInt(10000), time.Hour, true)
+	sellOrder := s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("0.51"), math.NewInt(10000), time.Hour, true)
+	s.nextBlock()
+
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().NotNil(pair.LastPrice)
+	_, found := s.keeper.GetOrder(s.ctx, pair.Id, buyOrder.Id)
+	s.Require().False(found) // Completed and deleted.
+	_, found = s.keeper.GetOrder(s.ctx, pair.Id, sellOrder.Id)
+	s.Require().False(found)
+	staleOrder, found = s.keeper.GetOrder(s.ctx, pair.Id, staleOrder.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusNotMatched, staleOrder.Status)
+}
+
+func (s *KeeperTestSuite) TestExecuteMatchingOnPairsDeterministic() {
+	var pairs []types.Pair
+	for i := 0; i < 10; i++ {
+		baseDenom, quoteDenom := fmt.Sprintf("denom%d", 2*i+1), fmt.Sprintf("denom%d", 2*i+2)
+		pair := s.createPair(s.addr(0), baseDenom, quoteDenom, true)
+		s.createPool(s.addr(0), pair.Id, utils.ParseCoins(fmt.Sprintf("1000000%s,1000000%s", baseDenom, quoteDenom)), true)
+		for j := 0; j < 5; j++ {
+			s.buyLimitOrder(s.addr(j+1), pair.Id, utils.ParseDec("1.01"), math.NewInt(int64(1000*(i+j+1))), time.Hour, true)
+			s.sellLimitOrder(s.addr(j+6), pair.Id, utils.ParseDec("0.99"), math.NewInt(int64(1500*(i+j+1))), time.Hour, true)
+		}
+		pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+		pairs = append(pairs, pair)
+	}
+	// Pairs without orders and pools are skipped, which shouldn't change
+	// the result either.
+	for i := 10; i < 13; i++ {
+		pair := s.createPair(s.addr(0), fmt.Sprintf("denom%d", 2*i+1), fmt.Sprintf("denom%d", 2*i+2), true)
+		if i == 11 {
+			pair.LastPrice = utils.ParseDecP("1.0")
+			s.keeper.SetPair(s.ctx, pair)
+		}
+		pairs = append(pairs, pair)
+	}
+
+	serialCtx, _ := s.ctx.CacheContext()
+	serialCtx = serialCtx.WithEventManager(sdk.NewEventManager())
+	for _, pair := range pairs {
+		s.Require().NoError(s.keeper.ExecuteMatching(serialCtx, pair))
+		if pair.Id <= 10 {
+			pair, _ = s.keeper.GetPair(serialCtx, pair.Id)
+			s.Require().NotNil(pair.LastPrice) // Make sure orders have been matched.
+		}
+	}
+
+	for i := 0; i < 5; i++ {
+		parallelCtx, _ := s.ctx.CacheContext()
+		parallelCtx = parallelCtx.WithEventManager(sdk.NewEventManager())
+		// Pass pairs in the reversed order, which shouldn't matter.
+		reversed := make([]types.Pair, len(pairs))
+		for j, pair := range pairs {
+			reversed[len(pairs)-1-j] = pair
+		}
+		s.Require().NoError(s.keeper.ExecuteMatchingOnPairs(parallelCtx, reversed))
+
+		s.Require().Equal(s.keeper.ExportGenesis(serialCtx), s.keeper.ExportGenesis(parallelCtx))
+		s.Require().Equal(serialCtx.EventManager().Events(), parallelCtx.EventManager().Events())
+		for j := 0; j <= 10; j++ {
+			s.Require().Equal(
+				s.app.BankKeeper.GetAllBalances(serialCtx, s.addr(j)),
+				s.app.BankKeeper.GetAllBalances(parallelCtx, s.addr(j)))
+		}
+	}
+}
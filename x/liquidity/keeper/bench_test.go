@@ -1,53 +1,233 @@
 package keeper_test
 
-// func BenchmarkMatching(b *testing.B) {
-// 	app := chain.Setup(false)
-// 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
-// 	keeper := app.LiquidityKeeper
-
-// 	for i := 0; i < 2; i++ {
-// 		require.NoError(b, chain.FundAccount(
-// 			app.BankKeeper, ctx, utils.TestAddress(i),
-// 			utils.ParseCoins("9999999999999999denom1,9999999999999999denom2,9999999999999999stake")))
-// 	}
-
-// 	pair, err := keeper.CreatePair(ctx, types.NewMsgCreatePair(utils.TestAddress(0), "denom1", "denom2"))
-// 	require.NoError(b, err)
-// 	pair.LastPrice = utils.ParseDecP("0.99999")
-// 	keeper.SetPair(ctx, pair)
-
-// 	_, err = keeper.CreatePool(ctx, types.NewMsgCreatePool(
-// 		utils.TestAddress(0), pair.Id, utils.ParseCoins("1000_000000denom1,1000_00000denom2")))
-// 	require.NoError(b, err)
-
-// 	_, err = keeper.CreateRangedPool(ctx, types.NewMsgCreateRangedPool(
-// 		utils.TestAddress(0), pair.Id, utils.ParseCoins("1000_000000denom1,1000_000000denom2"),
-// 		utils.ParseDec("0.95"), utils.ParseDec("1.05"), utils.ParseDec("1.02")))
-// 	require.NoError(b, err)
-
-// 	_, err = keeper.CreateRangedPool(ctx, types.NewMsgCreateRangedPool(
-// 		utils.TestAddress(0), pair.Id, utils.ParseCoins("1000_000000denom1,1000_000000denom2"),
-// 		utils.ParseDec("0.9"), utils.ParseDec("1.2"), utils.ParseDec("0.98")))
-// 	require.NoError(b, err)
-
-// 	amt := math.NewInt(50_000000)
-// 	price := utils.ParseDec("1.05")
-// 	_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
-// 		utils.TestAddress(1), pair.Id, types.OrderDirectionSell,
-// 		sdk.NewCoin("denom1", amt), "denom2", price, amt, 0))
-// 	require.NoError(b, err)
-
-// 	amt = math.NewInt(100_000000)
-// 	price = utils.ParseDec("0.97")
-// 	_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
-// 		utils.TestAddress(1), pair.Id, types.OrderDirectionBuy,
-// 		sdk.NewCoin("denom2", amm.OfferCoinAmount(amm.Buy, price, amt)), "denom1", price, amt, 0))
-// 	require.NoError(b, err)
-
-// 	b.ResetTimer()
-
-// 	for i := 0; i < b.N; i++ {
-// 		cacheCtx, _ := ctx.CacheContext()
-// 		liquidity.EndBlocker(cacheCtx, keeper)
-// 	}
-// }
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	chain "shogun/app"
+	utils "shogun/types"
+	"shogun/x/liquidity"
+	"shogun/x/liquidity/amm"
+	"shogun/x/liquidity/types"
+)
+
+func BenchmarkMatching(b *testing.B) {
+	app := chain.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	keeper := app.LiquidityKeeper
+
+	for i := 0; i < 2; i++ {
+		require.NoError(b, chain.FundAccount(
+			app.BankKeeper, ctx, utils.TestAddress(i),
+			utils.ParseCoins("9999999999999999denom1,9999999999999999denom2,9999999999999999stake")))
+	}
+
+	pair, err := keeper.CreatePair(ctx, types.NewMsgCreatePair(utils.TestAddress(0), "denom1", "denom2"))
+	require.NoError(b, err)
+	pair.LastPrice = utils.ParseDecP("0.99999")
+	keeper.SetPair(ctx, pair)
+
+	_, err = keeper.CreatePool(ctx, types.NewMsgCreatePool(
+		utils.TestAddress(0), pair.Id, utils.ParseCoins("1000_000000denom1,1000_00000denom2")))
+	require.NoError(b, err)
+
+	_, err = keeper.CreateRangedPool(ctx, types.NewMsgCreateRangedPool(
+		utils.TestAddress(0), pair.Id, utils.ParseCoins("1000_000000denom1,1000_000000denom2"),
+		utils.ParseDec("0.95"), utils.ParseDec("1.05"), utils.ParseDec("1.02")))
+	require.NoError(b, err)
+
+	_, err = keeper.CreateRangedPool(ctx, types.NewMsgCreateRangedPool(
+		utils.TestAddress(0), pair.Id, utils.ParseCoins("1000_000000denom1,1000_000000denom2"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.2"), utils.ParseDec("0.98")))
+	require.NoError(b, err)
+
+	amt := math.NewInt(50_000000)
+	price := utils.ParseDec("1.05")
+	_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
+		utils.TestAddress(1), pair.Id, types.OrderDirectionSell,
+		sdk.NewCoin("denom1", amt), "denom2", price, amt, 0))
+	require.NoError(b, err)
+
+	amt = math.NewInt(100_000000)
+	price = utils.ParseDec("0.97")
+	_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
+		utils.TestAddress(1), pair.Id, types.OrderDirectionBuy,
+		sdk.NewCoin("denom2", amm.OfferCoinAmount(amm.Buy, price, amt)), "denom1", price, amt, 0))
+	require.NoError(b, err)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.CacheContext()
+		liquidity.EndBlocker(cacheCtx, keeper)
+	}
+}
+
+func BenchmarkExecuteRequestsWithRestingOrders(b *testing.B) {
+	for _, numOrders := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("%d orders", numOrders), func(b *testing.B) {
+			app := chain.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{}).
+				WithBlockTime(utils.ParseTime("2022-01-01T00:00:00Z"))
+			keeper := app.LiquidityKeeper
+
+			for i := 0; i < 2; i++ {
+				require.NoError(b, chain.FundAccount(
+					app.BankKeeper, ctx, utils.TestAddress(i),
+					utils.ParseCoins("9999999999999999denom1,9999999999999999denom2,9999999999999999stake")))
+			}
+
+			pair, err := keeper.CreatePair(ctx, types.NewMsgCreatePair(utils.TestAddress(0), "denom1", "denom2"))
+			require.NoError(b, err)
+			pair.LastPrice = utils.ParseDecP("1.0")
+			keeper.SetPair(ctx, pair)
+
+			// Resting orders which never match nor expire during the benchmark.
+			amt := math.NewInt(1_000000)
+			for i := 0; i < numOrders; i++ {
+				price := utils.ParseDec("1.05")
+				_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
+					utils.TestAddress(1), pair.Id, types.OrderDirectionSell,
+					sdk.NewCoin("denom1", amt), "denom2", price, amt, time.Hour))
+				require.NoError(b, err)
+			}
+			liquidity.EndBlocker(ctx, keeper)
+
+			// An order which expires in the next batch.
+			_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
+				utils.TestAddress(0), pair.Id, types.OrderDirectionBuy,
+				sdk.NewCoin("denom2", amt), "denom1", utils.ParseDec("0.95"), amt, time.Second))
+			require.NoError(b, err)
+			liquidity.EndBlocker(ctx, keeper)
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				keeper.ExecuteRequests(cacheCtx)
+			}
+		})
+	}
+}
+
+func BenchmarkExecuteMatchingWithDeepOrders(b *testing.B) {
+	for _, numOrders := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("%d orders", numOrders), func(b *testing.B) {
+			app := chain.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{}).
+				WithBlockTime(utils.ParseTime("2022-01-01T00:00:00Z"))
+			keeper := app.LiquidityKeeper
+
+			for i := 0; i < 2; i++ {
+				require.NoError(b, chain.FundAccount(
+					app.BankKeeper, ctx, utils.TestAddress(i),
+					utils.ParseCoins("9999999999999999denom1,9999999999999999denom2,9999999999999999stake")))
+			}
+
+			pair, err := keeper.CreatePair(ctx, types.NewMsgCreatePair(utils.TestAddress(0), "denom1", "denom2"))
+			require.NoError(b, err)
+			pair.LastPrice = utils.ParseDecP("1.0")
+			keeper.SetPair(ctx, pair)
+
+			// Orders which are deep out of the price limits after the last
+			// price moves.
+			amt := math.NewInt(1_000000)
+			for i := 0; i < numOrders; i++ {
+				_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
+					utils.TestAddress(1), pair.Id, types.OrderDirectionSell,
+					sdk.NewCoin("denom1", amt), "denom2", utils.ParseDec("1.05"), amt, time.Hour))
+				require.NoError(b, err)
+			}
+			liquidity.EndBlocker(ctx, keeper)
+
+			pair, _ = keeper.GetPair(ctx, pair.Id)
+			pair.LastPrice = utils.ParseDecP("0.5")
+			keeper.SetPair(ctx, pair)
+
+			price := utils.ParseDec("0.5")
+			_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
+				utils.TestAddress(0), pair.Id, types.OrderDirectionBuy,
+				sdk.NewCoin("denom2", amm.OfferCoinAmount(amm.Buy, price, amt)), "denom1", price, amt, time.Hour))
+			require.NoError(b, err)
+			_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
+				utils.TestAddress(1), pair.Id, types.OrderDirectionSell,
+				sdk.NewCoin("denom1", amt), "denom2", price, amt, time.Hour))
+			require.NoError(b, err)
+			pair, _ = keeper.GetPair(ctx, pair.Id)
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				require.NoError(b, keeper.ExecuteMatching(cacheCtx, pair))
+			}
+		})
+	}
+}
+
+func BenchmarkExecuteRequestsWithManyPairs(b *testing.B) {
+	for _, numPairs := range []int{10, 100} {
+		b.Run(fmt.Sprintf("%d pairs", numPairs), func(b *testing.B) {
+			app := chain.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{}).
+				WithBlockTime(utils.ParseTime("2022-01-01T00:00:00Z"))
+			keeper := app.LiquidityKeeper
+
+			var coins sdk.Coins
+			for i := 0; i < 2*numPairs; i++ {
+				coins = coins.Add(utils.ParseCoin(fmt.Sprintf("9999999999999999denom%d", i)))
+			}
+			coins = coins.Add(utils.ParseCoin("9999999999999999stake"))
+			for i := 0; i < 2; i++ {
+				require.NoError(b, chain.FundAccount(app.BankKeeper, ctx, utils.TestAddress(i), coins))
+			}
+
+			for i := 0; i < numPairs; i++ {
+				baseDenom, quoteDenom := fmt.Sprintf("denom%d", 2*i), fmt.Sprintf("denom%d", 2*i+1)
+				pair, err := keeper.CreatePair(ctx, types.NewMsgCreatePair(utils.TestAddress(0), baseDenom, quoteDenom))
+				require.NoError(b, err)
+				pair.LastPrice = utils.ParseDecP("0.99999")
+				keeper.SetPair(ctx, pair)
+
+				_, err = keeper.CreatePool(ctx, types.NewMsgCreatePool(
+					utils.TestAddress(0), pair.Id, sdk.NewCoins(
+						sdk.NewInt64Coin(baseDenom, 1000_000000), sdk.NewInt64Coin(quoteDenom, 1000_000000))))
+				require.NoError(b, err)
+
+				_, err = keeper.CreateRangedPool(ctx, types.NewMsgCreateRangedPool(
+					utils.TestAddress(0), pair.Id, sdk.NewCoins(
+						sdk.NewInt64Coin(baseDenom, 1000_000000), sdk.NewInt64Coin(quoteDenom, 1000_000000)),
+					utils.ParseDec("0.95"), utils.ParseDec("1.05"), utils.ParseDec("1.02")))
+				require.NoError(b, err)
+
+				amt := math.NewInt(50_000000)
+				price := utils.ParseDec("1.05")
+				_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
+					utils.TestAddress(1), pair.Id, types.OrderDirectionSell,
+					sdk.NewCoin(baseDenom, amt), quoteDenom, price, amt, 0))
+				require.NoError(b, err)
+
+				amt = math.NewInt(100_000000)
+				price = utils.ParseDec("0.97")
+				_, err = keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
+					utils.TestAddress(1), pair.Id, types.OrderDirectionBuy,
+					sdk.NewCoin(quoteDenom, amm.OfferCoinAmount(amm.Buy, price, amt)), baseDenom, price, amt, 0))
+				require.NoError(b, err)
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				keeper.ExecuteRequests(cacheCtx)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	utils "shogun/types"
+	"shogun/x/liquidity/types"
+)
+
+// typedEvents parses the typed events emitted in the context.
+func (s *KeeperTestSuite) typedEvents(ctx sdk.Context) (events []proto.Message) {
+	s.T().Helper()
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		s.Require().NoError(err)
+		events = append(events, msg)
+	}
+	return
+}
+
+// hasEvent returns true if an event of the type has been emitted in the
+// context.
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *KeeperTestSuite) TestTypedEvents() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	offerCoin := sdk.NewInt64Coin("denom2", 1_010_000)
+	s.fundAddr(s.addr(1), sdk.NewCoins(offerCoin))
+	order, err := s.keeper.LimitOrder(ctx, types.NewMsgLimitOrder(
+		s.addr(1), pair.Id, types.OrderDirectionBuy, offerCoin, "denom1",
+		utils.ParseDec("1.0"), math.NewInt(1_000_000), time.Hour))
+	s.Require().NoError(err)
+
+	events := s.typedEvents(ctx)
+	s.Require().Len(events, 1)
+	s.Require().Equal(&types.EventLimitOrder{
+		Orderer:         s.addr(1).String(),
+		PairId:          pair.Id,
+		OrderId:         order.Id,
+		BatchId:         order.BatchId,
+		Direction:       types.OrderDirectionBuy,
+		OfferCoin:       sdk.NewInt64Coin("denom2", 1_000_000),
+		DemandCoinDenom: "denom1",
+		Price:           utils.ParseDec("1.0"),
+		Amount:          math.NewInt(1_000_000),
+		ExpireAt:        order.ExpireAt,
+		RefundedCoins:   utils.ParseCoins("10000denom2"),
+	}, events[0])
+
+	// Legacy events are emitted along with the typed events by default.
+	s.Require().True(hasEvent(ctx, types.EventTypeLimitOrder))
+
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), math.NewInt(1_000_000), time.Hour, true)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+
+	ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.ExecuteMatching(ctx, pair))
+
+	var matched, results int
+	for _, event := range s.typedEvents(ctx) {
+		switch event := event.(type) {
+		case *types.EventUserOrderMatched:
+			matched++
+			s.Require().True(intEq(math.NewInt(1_000_000), event.MatchedAmount))
+		case *types.EventOrderResult:
+			results++
+			s.Require().Equal(types.OrderStatusCompleted, event.Status)
+		}
+	}
+	s.Require().Equal(2, matched)
+	s.Require().Equal(2, results)
+}
+
+func (s *KeeperTestSuite) TestLegacyEventsDisabled() {
+	k := s.keeper
+	k.SetEmitLegacyEvents(false)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	creator := s.addr(0)
+	s.fundAddr(creator, k.GetPairCreationFee(ctx))
+	pair, err := k.CreatePair(ctx, types.NewMsgCreatePair(creator, "denom1", "denom2"))
+	s.Require().NoError(err)
+
+	s.Require().False(hasEvent(ctx, types.EventTypeCreatePair))
+	s.Require().Equal([]proto.Message{&types.EventCreatePair{
+		Creator:        creator.String(),
+		PairId:         pair.Id,
+		BaseCoinDenom:  "denom1",
+		QuoteCoinDenom: "denom2",
+		EscrowAddress:  pair.EscrowAddress,
+	}}, s.typedEvents(ctx))
+}
+
+func (s *KeeperTestSuite) TestBatchExecutedEvent() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), math.NewInt(1_000_000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), math.NewInt(1_000_000), time.Hour, true)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+
+	batchExecuted := func(ctx sdk.Context) *types.EventBatchExecuted {
+		var events []*types.EventBatchExecuted
+		for _, event := range s.typedEvents(ctx) {
+			if event, ok := event.(*types.EventBatchExecuted); ok {
+				events = append(events, event)
+			}
+		}
+		s.Require().Len(events, 1)
+		return events[0]
+	}
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.ExecuteMatching(ctx, pair))
+	matchPrice := utils.ParseDec("1.0")
+	s.Require().Equal(&types.EventBatchExecuted{
+		PairId:     pair.Id,
+		BatchId:    pair.CurrentBatchId,
+		Matched:    true,
+		MatchPrice: &matchPrice,
+		// The first batch of the pair doesn't have the price direction.
+		PriceDirection:     types.PriceDirectionUnspecified,
+		MatchedBaseAmount:  math.NewInt(1_000_000),
+		MatchedQuoteAmount: math.NewInt(1_000_000),
+		NumFilledOrders:    2,
+		Dust:               sdk.NewInt64Coin("denom2", 0),
+		LastPrice:          &matchPrice,
+	}, batchExecuted(ctx))
+	s.Require().True(hasEvent(ctx, types.EventTypeBatchExecuted))
+
+	// The event is emitted even if nothing has been matched.
+	pair, _ = s.keeper.GetPair(ctx, pair.Id)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.ExecuteMatching(ctx, pair))
+	s.Require().Equal(&types.EventBatchExecuted{
+		PairId:             pair.Id,
+		BatchId:            pair.CurrentBatchId,
+		PriceDirection:     types.PriceDirectionStaying,
+		MatchedBaseAmount:  math.ZeroInt(),
+		MatchedQuoteAmount: math.ZeroInt(),
+		Dust:               sdk.NewInt64Coin("denom2", 0),
+		LastPrice:          &matchPrice,
+	}, batchExecuted(ctx))
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"shogun/x/liquidity/types"
+)
+
+// FeePair returns the pair between the fee denom and the native denom, which
+// is used to value and convert fees paid in the fee denom.
+// The fee denom must be one of the fee denoms in params, and the pair must
+// have a last price.
+func (k Keeper) FeePair(ctx sdk.Context, feeDenom, nativeDenom string) (types.Pair, error) {
+	if !containsDenom(k.GetFeeDenoms(ctx), feeDenom) {
+		return types.Pair{}, sdkerrors.Wrapf(types.ErrFeeNotConvertible, "%s is not a fee denom", feeDenom)
+	}
+	pair, found := k.GetPairByDenoms(ctx, feeDenom, nativeDenom)
+	if !found {
+		pair, found = k.GetPairByDenoms(ctx, nativeDenom, feeDenom)
+		if !found {
+			return types.Pair{}, sdkerrors.Wrapf(
+				types.ErrFeeNotConvertible, "no pair between %s and %s", feeDenom, nativeDenom)
+		}
+	}
+	if pair.LastPrice == nil {
+		return types.Pair{}, sdkerrors.Wrapf(types.ErrFeeNotConvertible, "pair %d does not have last price", pair.Id)
+	}
+	return pair, nil
+}
+
+// FeePrice returns the price of the fee pair to value fees by.
+// Only the enabled pools of the pair holding at least the minimum fee pool
+// reserve of the native coin are considered, and the lowest value of the fee
+// denom among their prices is taken, so that a pool can't raise the value.
+// Unlike the pair's last price, the pool prices can't be moved within a
+// block, since swaps, deposits and withdrawals are executed in batches.
+func (k Keeper) FeePrice(ctx sdk.Context, pair types.Pair, nativeDenom string) (math.LegacyDec, error) {
+	minReserve := k.GetMinFeePoolReserve(ctx)
+	var price math.LegacyDec
+	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
+		if pool.Disabled {
+			return false, nil
+		}
+		rx, ry := k.getPoolBalances(ctx, pool, pair)
+		nativeReserve := rx
+		if pair.BaseCoinDenom == nativeDenom {
+			nativeReserve = ry
+		}
+		if nativeReserve.Amount.LT(minReserve) {
+			return false, nil
+		}
+		ammPool := pool.AMMPool(rx.Amount, ry.Amount, k.GetPoolCoinSupply(ctx, pool))
+		if ammPool.IsDepleted() {
+			return false, nil
+		}
+		poolPrice := ammPool.Price()
+		switch {
+		case price.IsNil():
+			price = poolPrice
+		case pair.BaseCoinDenom == nativeDenom && poolPrice.GT(price):
+			price = poolPrice
+		case pair.QuoteCoinDenom == nativeDenom && poolPrice.LT(price):
+			price = poolPrice
+		}
+		return false, nil
+	})
+	if price.IsNil() {
+		return math.LegacyDec{}, sdkerrors.Wrapf(
+			types.ErrFeeNotConvertible, "pair %d does not have an enabled pool with %s reserve of %s",
+			pair.Id, nativeDenom, minReserve)
+	}
+	return price, nil
+}
+
+// FeeValue returns the value of the fee coin in the native coin at the price
+// of the fee pair's pools, discounted by the haircut.
+func (k Keeper) FeeValue(ctx sdk.Context, feeCoin sdk.Coin, nativeDenom string, haircut math.LegacyDec) (sdk.Coin, error) {
+	pair, err := k.FeePair(ctx, feeCoin.Denom, nativeDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	price, err := k.FeePrice(ctx, pair, nativeDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	value := math.LegacyNewDecFromInt(feeCoin.Amount)
+	if pair.BaseCoinDenom == feeCoin.Denom {
+		value = value.Mul(price)
+	} else {
+		value = value.QuoTruncate(price)
+	}
+	value = value.MulTruncate(math.LegacyOneDec().Sub(haircut))
+	return sdk.NewCoin(nativeDenom, value.TruncateInt()), nil
+}
+
+// CollectFee collects the fee coin paid by the payer in a non-native coin.
+// The fee coin is kept in the fee abstraction address until it's converted
+// by ConvertFees.
+func (k Keeper) CollectFee(ctx sdk.Context, payer sdk.AccAddress, feeCoin sdk.Coin) error {
+	if err := k.bankKeeper.SendCoins(ctx, payer, types.FeeAbstractionAddress, sdk.NewCoins(feeCoin)); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+	return nil
+}
+
+// ConvertFees converts the fees collected in the fee abstraction address to
+// the native coin, which is called once per batch.
+// The native coins converted by the previous batches are sent to the fee
+// collector, and a market order converting all the balance is made for each
+// fee denom.
+func (k Keeper) ConvertFees(ctx sdk.Context) {
+	nativeDenom := k.stakingKeeper.BondDenom(ctx)
+	converted := k.bankKeeper.GetBalance(ctx, types.FeeAbstractionAddress, nativeDenom)
+	if converted.IsPositive() {
+		feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		if err := k.bankKeeper.SendCoins(ctx, types.FeeAbstractionAddress, feeCollector, sdk.NewCoins(converted)); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, feeDenom := range k.GetFeeDenoms(ctx) {
+		// The collected coins are converted in a later batch if the order
+		// fails, like when the balance is too small.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.convertFees(cacheCtx, feeDenom, nativeDenom); err == nil {
+			writeCache()
+		}
+	}
+}
+
+// convertFees makes a market order converting the fee abstraction address's
+// balance of the fee denom to the native coin.
+func (k Keeper) convertFees(ctx sdk.Context, feeDenom, nativeDenom string) error {
+	pair, err := k.FeePair(ctx, feeDenom, nativeDenom)
+	if err != nil {
+		return err
+	}
+	offerCoin := k.bankKeeper.GetBalance(ctx, types.FeeAbstractionAddress, feeDenom)
+	if !offerCoin.IsPositive() {
+		return nil
+	}
+	var msg *types.MsgMarketOrder
+	if pair.BaseCoinDenom == feeDenom {
+		msg = types.NewMsgMarketOrder(
+			types.FeeAbstractionAddress, pair.Id, types.OrderDirectionSell, offerCoin, nativeDenom,
+			offerCoin.Amount, 0)
+	} else {
+		// The amount of a buy market order is determined by the highest
+		// price allowed.
+		_, highest := k.PriceLimits(ctx, *pair.LastPrice)
+		msg = types.NewMsgMarketOrder(
+			types.FeeAbstractionAddress, pair.Id, types.OrderDirectionBuy, offerCoin, nativeDenom,
+			math.LegacyNewDecFromInt(offerCoin.Amount).QuoTruncate(highest).TruncateInt(), 0)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	_, err = k.MarketOrder(ctx, msg)
+	return err
+}
+
+func containsDenom(denoms []string, denom string) bool {
+	for _, d := range denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
@@ -29,18 +29,30 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, req := range genState.DepositRequests {
 		k.SetDepositRequest(ctx, req)
 		k.SetDepositRequestIndex(ctx, req)
+		if req.Status.ShouldBeDeleted() {
+			k.SetDepositRequestDeletionQueue(ctx, k.deletionHeight(ctx), req)
+		}
 	}
 	for _, req := range genState.WithdrawRequests {
 		k.SetWithdrawRequest(ctx, req)
 		k.SetWithdrawRequestIndex(ctx, req)
+		if req.Status.ShouldBeDeleted() {
+			k.SetWithdrawRequestDeletionQueue(ctx, k.deletionHeight(ctx), req)
+		}
 	}
 	for _, order := range genState.Orders {
 		k.SetOrder(ctx, order)
 		k.SetOrderIndex(ctx, order)
+		if order.Status.ShouldBeDeleted() {
+			k.SetOrderDeletionQueue(ctx, k.deletionHeight(ctx), order)
+		}
 	}
 	for _, index := range genState.MarketMakingOrderIndexes {
 		k.SetMMOrderIndex(ctx, index)
 	}
+	for _, req := range genState.ICARequests {
+		k.SetICARequest(ctx, req)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -55,5 +67,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		WithdrawRequests:         k.GetAllWithdrawRequests(ctx),
 		Orders:                   k.GetAllOrders(ctx),
 		MarketMakingOrderIndexes: k.GetAllMMOrderIndexes(ctx),
+		ICARequests:              k.GetAllICARequests(ctx),
 	}
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"cosmossdk.io/math"
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 
 	"shogun/x/liquidity/amm"
 	"shogun/x/liquidity/types"
@@ -26,9 +28,7 @@ var _ types.QueryServer = Querier{}
 // Params queries the parameters of the liquidity module.
 func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.Keeper.paramSpace.GetParamSet(ctx, &params)
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
 
 // Pairs queries all pairs.
@@ -393,38 +393,219 @@ func (k Querier) WithdrawRequest(c context.Context, req *types.QueryWithdrawRequ
 	return &types.QueryWithdrawRequestResponse{WithdrawRequest: wq}, nil
 }
 
-// Orders queries all orders.
-func (k Querier) Orders(c context.Context, req *types.QueryOrdersRequest) (*types.QueryOrdersResponse, error) {
+// SimulateDeposit queries the result of depositing coins to the pool, which is
+// computed like a deposit request is executed against the current reserves.
+func (k Querier) SimulateDeposit(c context.Context, req *types.QuerySimulateDepositRequest) (*types.QuerySimulateDepositResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if req.PairId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
+	}
+
+	if err := req.DepositCoins.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid deposit coins: %v", err)
+	}
+
+	if len(req.DepositCoins) == 0 || len(req.DepositCoins) > 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "wrong number of deposit coins: %d", len(req.DepositCoins))
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	store := ctx.KVStore(k.storeKey)
-	drsStore := prefix.NewStore(store, types.OrderKeyPrefix)
 
-	var orders []types.Order
-	pageRes, err := query.FilteredPaginate(drsStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
-		order, err := types.UnmarshalOrder(k.cdc, value)
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pool %d doesn't exist", req.PoolId)
+	}
+
+	msg := &types.MsgDeposit{PoolId: req.PoolId, DepositCoins: req.DepositCoins}
+	if err := k.ValidateMsgDeposit(ctx, msg); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	acceptedCoins, mintedPoolCoin, _ := k.depositResult(ctx, pool, req.DepositCoins)
+	if mintedPoolCoin.IsZero() {
+		acceptedCoins = sdk.Coins{}
+	}
+
+	return &types.QuerySimulateDepositResponse{
+		AcceptedCoins:  acceptedCoins,
+		RefundedCoins:  req.DepositCoins.Sub(acceptedCoins...),
+		MintedPoolCoin: mintedPoolCoin,
+	}, nil
+}
+
+// SimulateWithdraw queries the result of withdrawing the pool coin from the
+// pool, which is computed like a withdraw request is executed against the
+// current reserves.
+func (k Querier) SimulateWithdraw(c context.Context, req *types.QuerySimulateWithdrawRequest) (*types.QuerySimulateWithdrawResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
+	}
+
+	if err := req.PoolCoin.Validate(); err != nil || !req.PoolCoin.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pool coin: %s", req.PoolCoin)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pool %d doesn't exist", req.PoolId)
+	}
+
+	msg := &types.MsgWithdraw{PoolId: req.PoolId, PoolCoin: req.PoolCoin}
+	if err := k.ValidateMsgWithdraw(ctx, msg); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	withdrawnCoins, ps, _ := k.withdrawResult(ctx, pool, req.PoolCoin, k.GetWithdrawFeeRate(ctx))
+	if req.PoolCoin.Amount.GT(ps) {
+		return nil, status.Errorf(codes.InvalidArgument, "pool coin %s exceeds the supply %s", req.PoolCoin, ps)
+	}
+	withdrawFeeCoins := sdk.Coins{}
+	if !withdrawnCoins.IsZero() {
+		feelessCoins, _, _ := k.withdrawResult(ctx, pool, req.PoolCoin, math.LegacyZeroDec())
+		withdrawFeeCoins = feelessCoins.Sub(withdrawnCoins...)
+	}
+
+	return &types.QuerySimulateWithdrawResponse{
+		WithdrawnCoins:   withdrawnCoins,
+		WithdrawFeeCoins: withdrawFeeCoins,
+	}, nil
+}
+
+// Positions queries the liquidity positions of an account, which are the pool
+// coins held, with its pending deposit and withdraw requests.
+func (k Querier) Positions(c context.Context, req *types.QueryPositionsRequest) (*types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "address %s is invalid", req.Address)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	positions := []types.PositionResponse{}
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, addr) {
+		poolId, err := types.ParsePoolCoinDenom(coin.Denom)
 		if err != nil {
-			return false, err
+			continue
 		}
-
-		if order.PairId != req.PairId {
-			return false, nil
+		pool, found := k.GetPool(ctx, poolId)
+		if !found {
+			continue
 		}
+		positions = append(positions, k.position(ctx, pool, coin))
+	}
 
-		if accumulate {
-			orders = append(orders, order)
+	depositReqs := []types.DepositRequest{}
+	_ = k.IterateDepositRequestsByDepositor(ctx, addr, func(req types.DepositRequest) (stop bool, err error) {
+		if req.Status == types.RequestStatusNotExecuted {
+			depositReqs = append(depositReqs, req)
 		}
+		return false, nil
+	})
 
-		return true, nil
+	withdrawReqs := []types.WithdrawRequest{}
+	_ = k.IterateWithdrawRequestsByWithdrawer(ctx, addr, func(req types.WithdrawRequest) (stop bool, err error) {
+		if req.Status == types.RequestStatusNotExecuted {
+			withdrawReqs = append(withdrawReqs, req)
+		}
+		return false, nil
 	})
 
+	return &types.QueryPositionsResponse{
+		Positions:        positions,
+		DepositRequests:  depositReqs,
+		WithdrawRequests: withdrawReqs,
+	}, nil
+}
+
+// position returns the position of the pool coin in the pool.
+// The redeemable coins are computed like a withdraw request is executed, so
+// they are zero if the pool can't be withdrawn from.
+func (k Querier) position(ctx sdk.Context, pool types.Pool, poolCoin sdk.Coin) types.PositionResponse {
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+
+	position := types.PositionResponse{
+		PoolId:   pool.Id,
+		PairId:   pool.PairId,
+		PoolCoin: poolCoin,
+		Share:    math.LegacyNewDecFromInt(poolCoin.Amount).QuoInt(ps),
+		Redeemable: types.PoolBalances{
+			BaseCoin:  sdk.NewInt64Coin(pair.BaseCoinDenom, 0),
+			QuoteCoin: sdk.NewInt64Coin(pair.QuoteCoinDenom, 0),
+		},
+		Value:    sdk.NewInt64Coin(pair.QuoteCoinDenom, 0),
+		Disabled: pool.Disabled,
+	}
+
+	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
+	if pool.Disabled || ammPool.IsDepleted() {
+		return position
+	}
+
+	x, y := amm.Withdraw(rx.Amount, ry.Amount, ps, poolCoin.Amount, k.GetWithdrawFeeRate(ctx))
+	position.Redeemable.QuoteCoin.Amount = x
+	position.Redeemable.BaseCoin.Amount = y
+	position.Value.Amount = x.Add(ammPool.Price().MulInt(y).TruncateInt())
+	return position
+}
+
+// Orders queries all orders.
+func (k Querier) Orders(c context.Context, req *types.QueryOrdersRequest) (*types.QueryOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	filter, err := newOrderFilter(req.Status, req.Direction, req.OrderType, req.MinBatchId)
+	if err != nil {
+		return nil, err
+	}
+	pageReq, err := sortedPageRequest(req.Pagination, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	var orders []types.Order
+	var pageRes *query.PageResponse
+	if field, value, ok := filter.indexField(); ok {
+		indexStore := prefix.NewStore(store, types.GetOrdersByFieldIndexKeyPrefix(req.PairId, field, value))
+		pageRes, err = query.FilteredPaginate(indexStore, pageReq, func(key, _ []byte, accumulate bool) (bool, error) {
+			order, found := k.GetOrder(ctx, req.PairId, sdk.BigEndianToUint64(key))
+			if !found {
+				return false, fmt.Errorf("order %d in pair %d not found", sdk.BigEndianToUint64(key), req.PairId)
+			}
+			return filter.collect(&orders, order, accumulate), nil
+		})
+	} else {
+		orderStore := prefix.NewStore(store, types.GetOrdersByPairKeyPrefix(req.PairId))
+		pageRes, err = query.FilteredPaginate(orderStore, pageReq, func(key, value []byte, accumulate bool) (bool, error) {
+			order, err := types.UnmarshalOrder(k.cdc, value)
+			if err != nil {
+				return false, err
+			}
+			return filter.collect(&orders, order, accumulate), nil
+		})
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -467,31 +648,208 @@ func (k Querier) OrdersByOrderer(c context.Context, req *types.QueryOrdersByOrde
 		return nil, status.Errorf(codes.InvalidArgument, "orderer address %s is invalid", req.Orderer)
 	}
 
+	filter, err := newOrderFilter(req.Status, req.Direction, req.OrderType, req.MinBatchId)
+	if err != nil {
+		return nil, err
+	}
+	pageReq, err := sortedPageRequest(req.Pagination, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	store := ctx.KVStore(k.storeKey)
 
-	keyPrefix := types.GetOrderIndexKeyPrefix(orderer)
+	field, value, indexed := filter.indexField()
+	var keyPrefix []byte
+	switch {
+	case indexed && req.PairId == 0:
+		keyPrefix = types.GetOrdersByOrdererFieldIndexKeyPrefix(orderer, field, value)
+	case indexed:
+		keyPrefix = types.GetOrdersByOrdererFieldIndexKeyPrefixByPair(orderer, field, value, req.PairId)
+	case req.PairId == 0:
+		keyPrefix = types.GetOrderIndexKeyPrefix(orderer)
+	default:
+		keyPrefix = types.GetOrderIndexKeyPrefixByPair(orderer, req.PairId)
+	}
 	orderStore := prefix.NewStore(store, keyPrefix)
 	var orders []types.Order
-	pageRes, err := query.FilteredPaginate(orderStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
-		_, pairId, orderId := types.ParseOrderIndexKey(append(keyPrefix, key...))
-		if req.PairId != 0 && pairId != req.PairId {
-			return false, nil
+	pageRes, err := query.FilteredPaginate(orderStore, pageReq, func(key, value []byte, accumulate bool) (bool, error) {
+		var pairId, orderId uint64
+		if indexed {
+			_, _, _, pairId, orderId = types.ParseOrdersByOrdererFieldIndexKey(append(keyPrefix, key...))
+		} else {
+			_, pairId, orderId = types.ParseOrderIndexKey(append(keyPrefix, key...))
+		}
+		order, found := k.GetOrder(ctx, pairId, orderId)
+		if !found {
+			return false, fmt.Errorf("order %d in pair %d not found", orderId, pairId)
 		}
+		return filter.collect(&orders, order, accumulate), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-		order, _ := k.GetOrder(ctx, pairId, orderId)
+	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+}
 
-		if accumulate {
-			orders = append(orders, order)
+// MMOrders returns market making orders made by an orderer in a pair.
+func (k Querier) MMOrders(c context.Context, req *types.QueryMMOrdersRequest) (*types.QueryMMOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	orderer, err := sdk.AccAddressFromBech32(req.Orderer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "orderer address %s is invalid", req.Orderer)
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	index, found := k.GetMMOrderIndex(ctx, orderer, req.PairId)
+	if !found {
+		return &types.QueryMMOrdersResponse{Orders: []types.Order{}}, nil
+	}
+
+	return &types.QueryMMOrdersResponse{Orders: k.mmOrders(ctx, index)}, nil
+}
+
+// MMOrdersByOrderer returns market making orders made by an orderer in all
+// pairs.
+func (k Querier) MMOrdersByOrderer(c context.Context, req *types.QueryMMOrdersByOrdererRequest) (*types.QueryMMOrdersByOrdererResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	orderer, err := sdk.AccAddressFromBech32(req.Orderer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "orderer address %s is invalid", req.Orderer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetMMOrderIndexKeyPrefix(orderer))
+
+	var mmOrders []types.MMOrdersResponse
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key, value []byte) error {
+		var index types.MMOrderIndex
+		if err := k.cdc.Unmarshal(value, &index); err != nil {
+			return err
 		}
 
-		return true, nil
+		mmOrders = append(mmOrders, types.MMOrdersResponse{
+			PairId: index.PairId,
+			Orders: k.mmOrders(ctx, index),
+		})
+
+		return nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+	return &types.QueryMMOrdersByOrdererResponse{MMOrders: mmOrders, Pagination: pageRes}, nil
+}
+
+// mmOrders returns the orders in the market making order index.
+func (k Querier) mmOrders(ctx sdk.Context, index types.MMOrderIndex) []types.Order {
+	orders := make([]types.Order, 0, len(index.OrderIds))
+	for _, orderId := range index.OrderIds {
+		order, found := k.GetOrder(ctx, index.PairId, orderId)
+		if found {
+			orders = append(orders, order)
+		}
+	}
+	return orders
+}
+
+// orderFilter filters orders queried by their fields.
+// Zero values of the fields match any order.
+type orderFilter struct {
+	status     types.OrderStatus
+	direction  types.OrderDirection
+	orderType  types.OrderType
+	minBatchId uint64
+}
+
+// newOrderFilter returns a new orderFilter after validating the fields.
+func newOrderFilter(
+	orderStatus types.OrderStatus, dir types.OrderDirection, orderType types.OrderType, minBatchId uint64) (orderFilter, error) {
+	if _, ok := types.OrderStatus_name[int32(orderStatus)]; !ok {
+		return orderFilter{}, status.Errorf(codes.InvalidArgument, "invalid order status: %d", orderStatus)
+	}
+	if _, ok := types.OrderDirection_name[int32(dir)]; !ok {
+		return orderFilter{}, status.Errorf(codes.InvalidArgument, "invalid order direction: %d", dir)
+	}
+	if _, ok := types.OrderType_name[int32(orderType)]; !ok {
+		return orderFilter{}, status.Errorf(codes.InvalidArgument, "invalid order type: %d", orderType)
+	}
+	return orderFilter{
+		status:     orderStatus,
+		direction:  dir,
+		orderType:  orderType,
+		minBatchId: minBatchId,
+	}, nil
+}
+
+func (f orderFilter) matches(order types.Order) bool {
+	return (f.status == types.OrderStatusUnspecified || order.Status == f.status) &&
+		(f.direction == types.OrderDirectionUnspecified || order.Direction == f.direction) &&
+		(f.orderType == types.OrderTypeUnspecified || order.Type == f.orderType) &&
+		order.BatchId >= f.minBatchId
+}
+
+// indexField returns the field and its value to iterate orders by the index
+// of, which is the most selective field filtered.
+// The other fields are checked on each order iterated.
+func (f orderFilter) indexField() (field types.OrderIndexField, value byte, ok bool) {
+	switch {
+	case f.status != types.OrderStatusUnspecified:
+		return types.OrderIndexFieldStatus, byte(f.status), true
+	case f.orderType != types.OrderTypeUnspecified:
+		return types.OrderIndexFieldType, byte(f.orderType), true
+	case f.direction != types.OrderDirectionUnspecified:
+		return types.OrderIndexFieldDirection, byte(f.direction), true
+	default:
+		return 0, 0, false
+	}
+}
+
+// collect appends the order to orders if it matches the filter and
+// accumulate is true, and returns whether it matches.
+func (f orderFilter) collect(orders *[]types.Order, order types.Order, accumulate bool) bool {
+	if !f.matches(order) {
+		return false
+	}
+	if accumulate {
+		*orders = append(*orders, order)
+	}
+	return true
+}
+
+// sortedPageRequest returns the page request iterating orders in the sort
+// order.
+// Order ids are the last part of the keys iterated, so sorting by id is done
+// by iterating the keys in reverse.
+func sortedPageRequest(pageReq *query.PageRequest, sort types.OrderSort) (*query.PageRequest, error) {
+	switch sort {
+	case types.OrderSortIdAsc:
+		return pageReq, nil
+	case types.OrderSortIdDesc:
+		if pageReq == nil {
+			return &query.PageRequest{Reverse: true}, nil
+		}
+		sorted := *pageReq
+		sorted.Reverse = true
+		return &sorted, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order sort: %d", sort)
+	}
 }
 
 // OrderBooks queries virtual order books from user orders and pools.
@@ -543,18 +901,17 @@ func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest
 			return nil, status.Errorf(codes.Unavailable, "pair %d does not have last price", pairId)
 		}
 
+		// Like the batch matching, only matchable orders within the price
+		// limits are taken from the price index.
+		lowestPrice, highestPrice := k.PriceLimits(ctx, *pair.LastPrice)
 		ob := amm.NewOrderBook()
-		_ = k.IterateOrdersByPair(ctx, pairId, func(order types.Order) (stop bool, err error) {
-			switch order.Status {
-			case types.OrderStatusNotExecuted,
-				types.OrderStatusNotMatched,
-				types.OrderStatusPartiallyMatched:
-				ob.AddOrder(types.NewUserOrder(order))
-			}
+		addOrder := func(order types.Order) (stop bool, err error) {
+			ob.AddOrder(types.NewUserOrder(order))
 			return false, nil
-		})
+		}
+		_ = k.IterateBuyOrdersOver(ctx, pairId, lowestPrice, addOrder)
+		_ = k.IterateSellOrdersUnder(ctx, pairId, highestPrice, addOrder)
 
-		lowestPrice, highestPrice := k.PriceLimits(ctx, *pair.LastPrice)
 		_ = k.IteratePoolsByPair(ctx, pairId, func(pool types.Pool) (stop bool, err error) {
 			if pool.Disabled {
 				return false, nil
@@ -585,3 +942,27 @@ func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest
 		Pairs: pairs,
 	}, nil
 }
+
+// ICARequest queries the specific ICA request.
+func (k Querier) ICARequest(c context.Context, req *types.QueryICARequestRequest) (*types.QueryICARequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	icaReq, found := k.GetICARequest(ctx, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "ica request of channel %s and sequence %d doesn't exist", req.ChannelId, req.Sequence)
+	}
+
+	return &types.QueryICARequestResponse{Request: icaReq}, nil
+}
@@ -1,5 +1,15 @@
 package keeper_test
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	utils "shogun/types"
+	"shogun/x/liquidity/types"
+)
+
 // import (
 // 	"time"
 
@@ -1012,6 +1022,33 @@ package keeper_test
 // 	}
 // }
 
+func (s *KeeperTestSuite) TestGRPCOrderBooks_PriceLimits() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.95"), sdk.NewInt(1000000), time.Hour, true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.99"), sdk.NewInt(1000000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.05"), sdk.NewInt(1000000), time.Hour, true)
+
+	// The buy order at 0.95 falls out of the price limits.
+	pair.LastPrice = utils.ParseDecP("1.06")
+	s.keeper.SetPair(s.ctx, pair)
+
+	resp, err := s.querier.OrderBooks(sdk.WrapSDKContext(s.ctx), &types.QueryOrderBooksRequest{
+		PairIds:         []uint64{pair.Id},
+		PriceUnitPowers: []uint32{0},
+		NumTicks:        10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Pairs, 1)
+	ob := resp.Pairs[0].OrderBooks[0]
+	s.Require().Len(ob.Buys, 1)
+	s.Require().True(decEq(utils.ParseDec("0.99"), ob.Buys[0].Price))
+	s.Require().Len(ob.Sells, 1)
+	s.Require().True(decEq(utils.ParseDec("1.05"), ob.Sells[0].Price))
+}
+
 // func (s *KeeperTestSuite) TestEmptyOrderBook() {
 // 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 // 	pair.LastPrice = utils.ParseDecP("1.0") // manually set last price
@@ -1089,3 +1126,401 @@ package keeper_test
 // 		}
 // 	}
 // }
+
+func (s *KeeperTestSuite) TestGRPCOrdersFilters() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+
+	order := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	order2 := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(1000000), time.Hour, true)
+	order3 := s.buyLimitOrder(s.addr(1), pair2.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(500000), time.Hour, true)
+	s.nextBlock()
+	order4 := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.9"), sdk.NewInt(1000000), time.Hour, true)
+
+	orderIds := func(orders []types.Order) (ids []uint64) {
+		for _, order := range orders {
+			ids = append(ids, order.Id)
+		}
+		return
+	}
+
+	for _, tc := range []struct {
+		name        string
+		req         *types.QueryOrdersByOrdererRequest
+		expectedErr string
+		expected    []uint64
+	}{
+		{
+			"no filters",
+			&types.QueryOrdersByOrdererRequest{Orderer: s.addr(1).String()},
+			"",
+			[]uint64{order.Id, order2.Id, order4.Id, order3.Id},
+		},
+		{
+			"by status",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer: s.addr(1).String(),
+				Status:  types.OrderStatusPartiallyMatched,
+			},
+			"",
+			[]uint64{order.Id},
+		},
+		{
+			"by status changed",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer: s.addr(1).String(),
+				Status:  types.OrderStatusNotExecuted,
+			},
+			"",
+			[]uint64{order4.Id},
+		},
+		{
+			"by status and direction",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer:   s.addr(1).String(),
+				Status:    types.OrderStatusNotMatched,
+				Direction: types.OrderDirectionSell,
+			},
+			"",
+			[]uint64{order2.Id},
+		},
+		{
+			"by direction in the pair",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer:   s.addr(1).String(),
+				PairId:    pair.Id,
+				Direction: types.OrderDirectionBuy,
+			},
+			"",
+			[]uint64{order.Id, order4.Id},
+		},
+		{
+			"by order type",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer:   s.addr(1).String(),
+				OrderType: types.OrderTypeMarket,
+			},
+			"",
+			nil,
+		},
+		{
+			"by min batch id",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer:    s.addr(1).String(),
+				PairId:     pair.Id,
+				MinBatchId: order4.BatchId,
+			},
+			"",
+			[]uint64{order4.Id},
+		},
+		{
+			"descending",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer: s.addr(1).String(),
+				PairId:  pair.Id,
+				Sort:    types.OrderSortIdDesc,
+			},
+			"",
+			[]uint64{order4.Id, order2.Id, order.Id},
+		},
+		{
+			"paginated",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer:    s.addr(1).String(),
+				Direction:  types.OrderDirectionBuy,
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			"",
+			[]uint64{order4.Id},
+		},
+		{
+			"invalid status",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer: s.addr(1).String(),
+				Status:  100,
+			},
+			"rpc error: code = InvalidArgument desc = invalid order status: 100",
+			nil,
+		},
+		{
+			"invalid sort",
+			&types.QueryOrdersByOrdererRequest{
+				Orderer: s.addr(1).String(),
+				Sort:    100,
+			},
+			"rpc error: code = InvalidArgument desc = invalid order sort: 100",
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.OrdersByOrderer(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expected, orderIds(resp.Orders))
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+
+	resp, err := s.querier.Orders(sdk.WrapSDKContext(s.ctx), &types.QueryOrdersRequest{
+		PairId:    pair.Id,
+		Direction: types.OrderDirectionBuy,
+		Sort:      types.OrderSortIdDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{order4.Id, order.Id}, orderIds(resp.Orders))
+
+	// Deleted orders are removed from the indexes.
+	s.nextBlock()
+	s.cancelOrder(s.addr(1), pair.Id, order4.Id)
+	resp, err = s.querier.Orders(sdk.WrapSDKContext(s.ctx), &types.QueryOrdersRequest{
+		PairId: pair.Id,
+		Status: types.OrderStatusCanceled,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{order4.Id}, orderIds(resp.Orders))
+	s.nextBlock()
+	s.nextBlock()
+	resp, err = s.querier.Orders(sdk.WrapSDKContext(s.ctx), &types.QueryOrdersRequest{
+		PairId: pair.Id,
+		Status: types.OrderStatusCanceled,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Orders)
+}
+
+func (s *KeeperTestSuite) TestGRPCMMOrders() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+
+	orders := s.mmOrder(
+		s.addr(1), pair.Id,
+		utils.ParseDec("1.1"), utils.ParseDec("1.05"), newInt(100000),
+		utils.ParseDec("0.95"), utils.ParseDec("0.9"), newInt(100000),
+		time.Hour, true)
+	orders2 := s.mmOrder(
+		s.addr(1), pair2.Id,
+		utils.ParseDec("1.1"), utils.ParseDec("1.05"), newInt(100000),
+		utils.ParseDec("0.95"), utils.ParseDec("0.9"), sdk.ZeroInt(),
+		time.Hour, true)
+	// Orders which aren't market making orders aren't returned.
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.9"), newInt(10000), time.Hour, true)
+	// Orders are returned with their current status and fills.
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.9"), newInt(95000), time.Hour, true)
+	s.nextBlock()
+
+	orderIds := func(orders []types.Order) (ids []uint64) {
+		for _, order := range orders {
+			ids = append(ids, order.Id)
+		}
+		return
+	}
+
+	// Completed orders are deleted from the index.
+	var stored []types.Order
+	for _, order := range orders {
+		order, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+		if found {
+			stored = append(stored, order)
+		}
+	}
+	s.Require().Less(len(stored), len(orders))
+	s.Require().Equal(types.OrderStatusPartiallyMatched, stored[0].Status)
+
+	resp, err := s.querier.MMOrders(sdk.WrapSDKContext(s.ctx), &types.QueryMMOrdersRequest{
+		Orderer: s.addr(1).String(),
+		PairId:  pair.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(stored, resp.Orders)
+
+	resp, err = s.querier.MMOrders(sdk.WrapSDKContext(s.ctx), &types.QueryMMOrdersRequest{
+		Orderer: s.addr(2).String(),
+		PairId:  pair.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Orders)
+
+	_, err = s.querier.MMOrders(sdk.WrapSDKContext(s.ctx), &types.QueryMMOrdersRequest{
+		Orderer: s.addr(1).String(),
+	})
+	s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = pair id cannot be 0")
+
+	byOrdererResp, err := s.querier.MMOrdersByOrderer(sdk.WrapSDKContext(s.ctx), &types.QueryMMOrdersByOrdererRequest{
+		Orderer: s.addr(1).String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(byOrdererResp.MMOrders, 2)
+	s.Require().Equal(pair.Id, byOrdererResp.MMOrders[0].PairId)
+	s.Require().Equal(stored, byOrdererResp.MMOrders[0].Orders)
+	s.Require().Equal(pair2.Id, byOrdererResp.MMOrders[1].PairId)
+	s.Require().Equal(orderIds(orders2), orderIds(byOrdererResp.MMOrders[1].Orders))
+
+	byOrdererResp, err = s.querier.MMOrdersByOrderer(sdk.WrapSDKContext(s.ctx), &types.QueryMMOrdersByOrdererRequest{
+		Orderer:    s.addr(1).String(),
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(byOrdererResp.MMOrders, 1)
+	s.Require().NotNil(byOrdererResp.Pagination.NextKey)
+}
+
+func (s *KeeperTestSuite) TestGRPCPositions() {
+	params := s.keeper.GetParams(s.ctx)
+	params.WithdrawFeeRate = utils.ParseDec("0.003")
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,4000000denom2"), true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+	pool2 := s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000denom2,1000000denom3"), true)
+
+	poolCoin := s.getBalance(s.addr(0), pool.PoolCoinDenom)
+	s.sendCoins(s.addr(0), s.addr(1), sdk.NewCoins(sdk.NewCoin(pool.PoolCoinDenom, poolCoin.Amount.QuoRaw(4))))
+	s.fundAddr(s.addr(1), utils.ParseCoins("100denom1"))
+
+	depositReq := s.deposit(s.addr(1), pool2.Id, utils.ParseCoins("1000denom2,1000denom3"), true)
+	withdrawReq := s.withdraw(s.addr(1), pool.Id, sdk.NewCoin(pool.PoolCoinDenom, poolCoin.Amount.QuoRaw(8)))
+	// Requests of other accounts aren't included.
+	s.deposit(s.addr(3), pool2.Id, utils.ParseCoins("1000denom2,1000denom3"), true)
+	s.withdraw(s.addr(0), pool.Id, sdk.NewCoin(pool.PoolCoinDenom, poolCoin.Amount.QuoRaw(8)))
+
+	resp, err := s.querier.Positions(sdk.WrapSDKContext(s.ctx), &types.QueryPositionsRequest{
+		Address: s.addr(1).String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Positions, 1)
+	position := resp.Positions[0]
+	s.Require().Equal(pool.Id, position.PoolId)
+	s.Require().Equal(pair.Id, position.PairId)
+	s.Require().Equal(sdk.NewCoin(pool.PoolCoinDenom, poolCoin.Amount.QuoRaw(8)), position.PoolCoin)
+	s.Require().True(decEq(utils.ParseDec("0.125"), position.Share))
+	s.Require().True(coinEq(utils.ParseCoin("124625denom1"), position.Redeemable.BaseCoin))
+	s.Require().True(coinEq(utils.ParseCoin("498500denom2"), position.Redeemable.QuoteCoin))
+	s.Require().True(coinEq(utils.ParseCoin("997000denom2"), position.Value))
+	s.Require().False(position.Disabled)
+	s.Require().Equal([]types.DepositRequest{depositReq}, resp.DepositRequests)
+	s.Require().Equal([]types.WithdrawRequest{withdrawReq}, resp.WithdrawRequests)
+
+	// Executed requests aren't pending.
+	s.nextBlock()
+	resp, err = s.querier.Positions(sdk.WrapSDKContext(s.ctx), &types.QueryPositionsRequest{
+		Address: s.addr(1).String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Positions, 2)
+	s.Require().Empty(resp.DepositRequests)
+	s.Require().Empty(resp.WithdrawRequests)
+
+	resp, err = s.querier.Positions(sdk.WrapSDKContext(s.ctx), &types.QueryPositionsRequest{
+		Address: s.addr(2).String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Positions)
+
+	_, err = s.querier.Positions(sdk.WrapSDKContext(s.ctx), &types.QueryPositionsRequest{})
+	s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = address  is invalid")
+}
+
+func (s *KeeperTestSuite) TestGRPCSimulateDeposit() {
+	params := s.keeper.GetParams(s.ctx)
+	params.FinishedRecordRetentionBlocks = 2
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,4000000denom2"), true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+	rangedPool := s.createRangedPool(
+		s.addr(0), pair2.Id, utils.ParseCoins("1000000denom2,1000000denom3"),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"), true)
+
+	for _, tc := range []struct {
+		name         string
+		poolId       uint64
+		depositCoins sdk.Coins
+		succeeded    bool
+	}{
+		{"basic pool", pool.Id, utils.ParseCoins("1000denom1,1000denom2"), true},
+		{"ranged pool", rangedPool.Id, utils.ParseCoins("1000denom2,5000denom3"), true},
+		// The ranged pool has both coins in its reserves, so a deposit of a
+		// single coin is refunded.
+		{"ranged pool single coin", rangedPool.Id, utils.ParseCoins("1000denom3"), false},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.SimulateDeposit(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateDepositRequest{
+				PoolId:       tc.poolId,
+				DepositCoins: tc.depositCoins,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.succeeded, resp.MintedPoolCoin.IsPositive())
+			s.Require().Equal(tc.depositCoins, resp.AcceptedCoins.Add(resp.RefundedCoins...))
+
+			// The simulation matches the execution of the deposit request.
+			req := s.deposit(s.addr(1), tc.poolId, tc.depositCoins, true)
+			s.nextBlock()
+			req, found := s.keeper.GetDepositRequest(s.ctx, tc.poolId, req.Id)
+			s.Require().True(found)
+			if tc.succeeded {
+				s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+				s.Require().Equal(req.MintedPoolCoin, resp.MintedPoolCoin)
+			} else {
+				s.Require().Equal(types.RequestStatusFailed, req.Status)
+			}
+			s.Require().True(req.AcceptedCoins.IsEqual(resp.AcceptedCoins))
+		})
+	}
+
+	_, err := s.querier.SimulateDeposit(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateDepositRequest{
+		PoolId:       pool.Id,
+		DepositCoins: utils.ParseCoins("1000denom1"),
+	})
+	s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = wrong number of deposit coins: 1: invalid request")
+
+	_, err = s.querier.SimulateDeposit(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateDepositRequest{
+		PoolId:       10,
+		DepositCoins: utils.ParseCoins("1000denom1,1000denom2"),
+	})
+	s.Require().EqualError(err, "rpc error: code = NotFound desc = pool 10 doesn't exist")
+}
+
+func (s *KeeperTestSuite) TestGRPCSimulateWithdraw() {
+	params := s.keeper.GetParams(s.ctx)
+	params.WithdrawFeeRate = utils.ParseDec("0.003")
+	params.FinishedRecordRetentionBlocks = 2
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,4000000denom2"), true)
+	poolCoin := s.getBalance(s.addr(0), pool.PoolCoinDenom)
+	poolCoin.Amount = poolCoin.Amount.QuoRaw(10)
+
+	resp, err := s.querier.SimulateWithdraw(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateWithdrawRequest{
+		PoolId:   pool.Id,
+		PoolCoin: poolCoin,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseCoins("99700denom1,398800denom2"), resp.WithdrawnCoins)
+	s.Require().Equal(utils.ParseCoins("300denom1,1200denom2"), resp.WithdrawFeeCoins)
+
+	// The simulation matches the execution of the withdraw request.
+	req := s.withdraw(s.addr(0), pool.Id, poolCoin)
+	s.nextBlock()
+	req, found := s.keeper.GetWithdrawRequest(s.ctx, pool.Id, req.Id)
+	s.Require().True(found)
+	s.Require().Equal(req.WithdrawnCoins, resp.WithdrawnCoins)
+
+	_, err = s.querier.SimulateWithdraw(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateWithdrawRequest{
+		PoolId:   pool.Id,
+		PoolCoin: utils.ParseCoin("1000pool2"),
+	})
+	s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = wrong pool coin denom")
+
+	supply := s.keeper.GetPoolCoinSupply(s.ctx, pool)
+	_, err = s.querier.SimulateWithdraw(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateWithdrawRequest{
+		PoolId:   pool.Id,
+		PoolCoin: sdk.NewCoin(pool.PoolCoinDenom, supply.AddRaw(1)),
+	})
+	s.Require().Error(err)
+}
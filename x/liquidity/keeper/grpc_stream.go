@@ -0,0 +1,394 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"shogun/x/liquidity/types"
+)
+
+const (
+	// DefaultStreamNumTicks is the default number of ticks of order books
+	// streamed to subscribers.
+	DefaultStreamNumTicks = 20
+
+	// MaxStreamPairIds and MaxStreamNumTicks bound a subscription, since
+	// the order books of subscribed pairs are built on each commit.
+	// They're the same as the limits of deterministic OrderBooks queries.
+	MaxStreamPairIds  = 5
+	MaxStreamNumTicks = 20
+
+	// subscriberBufferSize is the number of block updates buffered for each
+	// subscriber. A subscriber falling behind more than that is dropped.
+	subscriberBufferSize = 16
+)
+
+var (
+	_ types.StreamServer       = (*Streamer)(nil)
+	_ baseapp.StreamingService = (*Streamer)(nil)
+)
+
+// Streamer implements the Stream gRPC service, which streams order books and
+// trades of pairs to subscribers.
+// Streamer is fed by the app's commit path, as a baseapp.StreamingService:
+// it collects the events emitted in EndBlock, and computes the order books of
+// subscribed pairs after each block is committed.
+type Streamer struct {
+	keeper Keeper
+	logger log.Logger
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	events      []abci.Event // events emitted in EndBlock of the current block
+}
+
+// NewStreamer returns a new Streamer.
+func NewStreamer(k Keeper, logger log.Logger) *Streamer {
+	return &Streamer{
+		keeper:      k,
+		logger:      logger.With("module", fmt.Sprintf("x/%s", types.ModuleName)),
+		subscribers: map[*subscriber]struct{}{},
+	}
+}
+
+type subscriber struct {
+	pairIds  []uint64
+	numTicks uint32
+	ch       chan *blockUpdate
+	err      error // set before ch is closed by the Streamer
+}
+
+// blockUpdate holds the state of pairs after a block, shared by all
+// subscribers.
+type blockUpdate struct {
+	height int64
+	pairs  map[uint64]*pairUpdate
+}
+
+type pairUpdate struct {
+	found      bool
+	books      map[uint32]types.OrderBookResponse // by the number of ticks
+	batch      *types.EventBatchExecuted
+	userTrades []types.EventUserOrderMatched
+	poolTrades []types.EventPoolOrderMatched
+}
+
+// SubscribeOrderBooks implements the gRPC Stream/SubscribeOrderBooks method.
+func (s *Streamer) SubscribeOrderBooks(req *types.SubscribeOrderBooksRequest, stream types.Stream_SubscribeOrderBooksServer) error {
+	if len(req.PairIds) == 0 {
+		return status.Error(codes.InvalidArgument, "pair ids must not be empty")
+	}
+	if len(req.PairIds) > MaxStreamPairIds {
+		return status.Errorf(codes.InvalidArgument, "number of pair ids must not exceed %d", MaxStreamPairIds)
+	}
+	if req.NumTicks > MaxStreamNumTicks {
+		return status.Errorf(codes.InvalidArgument, "number of ticks must not exceed %d", MaxStreamNumTicks)
+	}
+	pairIdSet := map[uint64]struct{}{}
+	for _, pairId := range req.PairIds {
+		if _, ok := pairIdSet[pairId]; ok {
+			return status.Errorf(codes.InvalidArgument, "duplicate pair id: %d", pairId)
+		}
+		pairIdSet[pairId] = struct{}{}
+	}
+	numTicks := req.NumTicks
+	if numTicks == 0 {
+		numTicks = DefaultStreamNumTicks
+	}
+
+	sub := &subscriber{
+		pairIds:  req.PairIds,
+		numTicks: numTicks,
+		ch:       make(chan *blockUpdate, subscriberBufferSize),
+	}
+	s.addSubscriber(sub)
+	defer s.removeSubscriber(sub)
+
+	// prevBooks holds the order books sent to the subscriber last time.
+	prevBooks := map[uint64]types.OrderBookResponse{}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case update, ok := <-sub.ch:
+			if !ok {
+				return sub.err
+			}
+			for _, pairId := range sub.pairIds {
+				pu := update.pairs[pairId]
+				if !pu.found {
+					return status.Errorf(codes.NotFound, "pair %d not found", pairId)
+				}
+				book := pu.books[sub.numTicks]
+				prevBook, sent := prevBooks[pairId]
+				msg := &types.OrderBookUpdate{
+					Height:          update.height,
+					PairId:          pairId,
+					Batch:           pu.batch,
+					UserOrderTrades: pu.userTrades,
+					PoolOrderTrades: pu.poolTrades,
+				}
+				if !sent || !priceUnitEqual(prevBook.PriceUnit, book.PriceUnit) {
+					msg.Snapshot = true
+					msg.OrderBook = book
+				} else {
+					msg.OrderBook = types.OrderBookResponse{
+						PriceUnit: book.PriceUnit,
+						Sells:     diffOrderBookTicks(prevBook.Sells, book.Sells),
+						Buys:      diffOrderBookTicks(prevBook.Buys, book.Buys),
+					}
+					if len(msg.OrderBook.Sells) == 0 && len(msg.OrderBook.Buys) == 0 &&
+						msg.Batch == nil && len(msg.UserOrderTrades) == 0 && len(msg.PoolOrderTrades) == 0 {
+						continue
+					}
+				}
+				if err := stream.Send(msg); err != nil {
+					return err
+				}
+				prevBooks[pairId] = book
+			}
+		}
+	}
+}
+
+// NumSubscribers returns the number of current subscribers.
+func (s *Streamer) NumSubscribers() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.subscribers)
+}
+
+func (s *Streamer) addSubscriber(sub *subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers[sub] = struct{}{}
+}
+
+func (s *Streamer) removeSubscriber(sub *subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscribers, sub)
+}
+
+// dropSubscriber removes the subscriber and closes its channel with the
+// error. The caller must hold s.mu.
+func (s *Streamer) dropSubscriber(sub *subscriber, err error) {
+	delete(s.subscribers, sub)
+	sub.err = err
+	close(sub.ch)
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (s *Streamer) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = nil
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+// It keeps the events emitted in EndBlock, which contain the trades.
+func (s *Streamer) ListenEndBlock(_ context.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = res.Events
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (s *Streamer) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener.
+// It sends the order books of subscribed pairs and the trades made in the
+// block to subscribers.
+// Errors are logged instead of being returned, since streaming must not
+// affect consensus.
+func (s *Streamer) ListenCommit(ctx context.Context, _ abci.ResponseCommit) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	events := s.events
+	s.events = nil
+	if len(s.subscribers) == 0 {
+		return nil
+	}
+
+	update, err := s.makeBlockUpdate(sdk.UnwrapSDKContext(ctx), events)
+	if err != nil {
+		s.logger.Error("failed to make order book updates", "error", err)
+		return nil
+	}
+	for sub := range s.subscribers {
+		select {
+		case sub.ch <- update:
+		default:
+			s.dropSubscriber(sub, status.Error(codes.ResourceExhausted, "subscriber is too slow"))
+		}
+	}
+	return nil
+}
+
+// makeBlockUpdate reads the state of subscribed pairs after the block.
+// The caller must hold s.mu.
+func (s *Streamer) makeBlockUpdate(ctx sdk.Context, events []abci.Event) (update *blockUpdate, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	// The state must not be touched by reading order books.
+	ctx, _ = ctx.
+		WithGasMeter(sdk.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager()).
+		CacheContext()
+
+	update = &blockUpdate{
+		height: ctx.BlockHeight(),
+		pairs:  map[uint64]*pairUpdate{},
+	}
+	for sub := range s.subscribers {
+		for _, pairId := range sub.pairIds {
+			pu, ok := update.pairs[pairId]
+			if !ok {
+				pu = &pairUpdate{books: map[uint32]types.OrderBookResponse{}}
+				update.pairs[pairId] = pu
+			}
+			if _, ok := pu.books[sub.numTicks]; ok {
+				continue
+			}
+			pair, found := s.keeper.GetPair(ctx, pairId)
+			if !found {
+				continue
+			}
+			pu.found = true
+			pu.books[sub.numTicks] = s.orderBook(ctx, pair, sub.numTicks)
+		}
+	}
+
+	for _, event := range events {
+		switch event.Type {
+		case proto.MessageName(&types.EventBatchExecuted{}),
+			proto.MessageName(&types.EventUserOrderMatched{}),
+			proto.MessageName(&types.EventPoolOrderMatched{}):
+		default:
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		switch msg := msg.(type) {
+		case *types.EventBatchExecuted:
+			if pu, ok := update.pairs[msg.PairId]; ok {
+				pu.batch = msg
+			}
+		case *types.EventUserOrderMatched:
+			if pu, ok := update.pairs[msg.PairId]; ok {
+				pu.userTrades = append(pu.userTrades, *msg)
+			}
+		case *types.EventPoolOrderMatched:
+			if pu, ok := update.pairs[msg.PairId]; ok {
+				pu.poolTrades = append(pu.poolTrades, *msg)
+			}
+		}
+	}
+	return update, nil
+}
+
+// orderBook returns the order book of the pair with the smallest price unit.
+// It returns an empty order book if the pair doesn't have the last price.
+func (s *Streamer) orderBook(ctx sdk.Context, pair types.Pair, numTicks uint32) types.OrderBookResponse {
+	if pair.LastPrice == nil {
+		return types.OrderBookResponse{}
+	}
+	resp, err := Querier{Keeper: s.keeper}.OrderBooks(sdk.WrapSDKContext(ctx), &types.QueryOrderBooksRequest{
+		PairIds:         []uint64{pair.Id},
+		PriceUnitPowers: []uint32{0},
+		NumTicks:        numTicks,
+	})
+	if err != nil {
+		panic(err) // shouldn't happen, since the pair has the last price
+	}
+	if len(resp.Pairs[0].OrderBooks) == 0 {
+		return types.OrderBookResponse{}
+	}
+	return resp.Pairs[0].OrderBooks[0]
+}
+
+// Stream implements baseapp.StreamingService.
+// Streamer doesn't have its own loop, since updates are sent by ListenCommit.
+func (s *Streamer) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService.
+// Streamer doesn't listen to store writes.
+func (s *Streamer) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Close implements baseapp.StreamingService.
+// It disconnects all subscribers.
+func (s *Streamer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subscribers {
+		s.dropSubscriber(sub, status.Error(codes.Unavailable, "streamer closed"))
+	}
+	return nil
+}
+
+// priceUnitEqual returns whether the two price units are equal, treating nil
+// price units of empty order books as equal to each other.
+func priceUnitEqual(a, b math.LegacyDec) bool {
+	if a.IsNil() || b.IsNil() {
+		return a.IsNil() == b.IsNil()
+	}
+	return a.Equal(b)
+}
+
+// diffOrderBookTicks returns ticks which have been added or changed in cur,
+// and ticks which have been removed from prev with zero amounts.
+// The result is sorted by price in descending order.
+func diffOrderBookTicks(prev, cur []types.OrderBookTickResponse) []types.OrderBookTickResponse {
+	prevByPrice := map[string]types.OrderBookTickResponse{}
+	for _, tick := range prev {
+		prevByPrice[tick.Price.String()] = tick
+	}
+	var diff []types.OrderBookTickResponse
+	for _, tick := range cur {
+		key := tick.Price.String()
+		prevTick, ok := prevByPrice[key]
+		delete(prevByPrice, key)
+		if ok && prevTick.UserOrderAmount.Equal(tick.UserOrderAmount) &&
+			prevTick.PoolOrderAmount.Equal(tick.PoolOrderAmount) {
+			continue
+		}
+		diff = append(diff, tick)
+	}
+	for _, tick := range prevByPrice {
+		diff = append(diff, types.OrderBookTickResponse{
+			Price:           tick.Price,
+			UserOrderAmount: sdk.ZeroInt(),
+			PoolOrderAmount: sdk.ZeroInt(),
+		})
+	}
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i].Price.GT(diff[j].Price)
+	})
+	return diff
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	chain "shogun/app"
+	utils "shogun/types"
+	"shogun/x/liquidity/types"
+)
+
+type IBCForwardTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	// chainA is the chain on which orders are made, and chainB is the
+	// destination of the received coins.
+	chainA, chainB *ibctesting.TestChain
+	path           *ibctesting.Path
+	pair           types.Pair
+}
+
+func TestIBCForwardTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCForwardTestSuite))
+}
+
+func (s *IBCForwardTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		encCfg := chain.MakeEncodingConfig()
+		app := chain.New(
+			log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, chain.DefaultNodeHome,
+			5, encCfg, simapp.EmptyAppOptions{})
+		return app, chain.NewDefaultGenesisState(encCfg.Marshaler)
+	}
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	s.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	s.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	s.coordinator.Setup(s.path)
+
+	app, ctx := s.appA(), s.chainA.GetContext()
+	creator := s.chainA.SenderAccount.GetAddress()
+	depositCoins := utils.ParseCoins("1000000000denom1,1000000000stake")
+	s.Require().NoError(chain.FundAccount(app.BankKeeper, ctx, creator, depositCoins))
+	var err error
+	s.pair, err = app.LiquidityKeeper.CreatePair(ctx, types.NewMsgCreatePair(creator, "denom1", sdk.DefaultBondDenom))
+	s.Require().NoError(err)
+	_, err = app.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, s.pair.Id, depositCoins))
+	s.Require().NoError(err)
+	s.coordinator.CommitBlock(s.chainA)
+}
+
+func (s *IBCForwardTestSuite) appA() *chain.App {
+	return s.chainA.App.(*chain.App)
+}
+
+// sellWithForward makes a sell order of 10000denom1 with the forward on
+// chainA and executes it right away.
+// It returns the order's received coin and the context of the execution.
+func (s *IBCForwardTestSuite) sellWithForward(orderer sdk.AccAddress, fwd *types.IBCForward) (sdk.Coin, sdk.Context) {
+	app, ctx := s.appA(), s.chainA.GetContext()
+	k := app.LiquidityKeeper
+	offerCoin := sdk.NewInt64Coin("denom1", 10000)
+	s.Require().NoError(chain.FundAccount(app.BankKeeper, ctx, orderer, sdk.NewCoins(offerCoin)))
+
+	msg := types.NewMsgLimitOrder(
+		orderer, s.pair.Id, types.OrderDirectionSell, offerCoin, sdk.DefaultBondDenom,
+		utils.ParseDec("0.9"), offerCoin.Amount, 0)
+	msg.Forward = fwd
+	s.Require().NoError(msg.ValidateBasic())
+	order, err := k.LimitOrder(ctx, msg)
+	s.Require().NoError(err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.ExecuteRequests(ctx)
+	order, found := k.GetOrder(ctx, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+	s.Require().True(order.ReceivedCoin.IsPositive())
+	s.coordinator.CommitBlock(s.chainA)
+	return order.ReceivedCoin, ctx
+}
+
+func (s *IBCForwardTestSuite) TestForward() {
+	orderer := utils.TestAddress(1)
+	receiver := s.chainB.SenderAccount.GetAddress()
+	receivedCoin, ctx := s.sellWithForward(orderer, &types.IBCForward{
+		ChannelId: s.path.EndpointA.ChannelID,
+		Receiver:  receiver.String(),
+		Timeout:   time.Hour,
+	})
+	s.Require().True(hasEvent(ctx, proto.MessageName(&types.EventIBCForward{})))
+
+	// The received coin has been sent over IBC instead of to the orderer.
+	s.Require().True(s.appA().BankKeeper.GetAllBalances(s.chainA.GetContext(), orderer).IsZero())
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	s.Require().NoError(err)
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, receivedCoin.Denom)).IBCDenom()
+	s.Require().Equal(receivedCoin.Amount, s.chainB.App.(*chain.App).BankKeeper.GetBalance(
+		s.chainB.GetContext(), receiver, voucherDenom).Amount)
+}
+
+func (s *IBCForwardTestSuite) TestForward_Timeout() {
+	orderer := utils.TestAddress(1)
+	receivedCoin, ctx := s.sellWithForward(orderer, &types.IBCForward{
+		ChannelId: s.path.EndpointA.ChannelID,
+		Receiver:  s.chainB.SenderAccount.GetAddress().String(),
+		Timeout:   time.Minute,
+	})
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	s.Require().NoError(err)
+
+	// The coin is refunded to the orderer when the transfer times out.
+	s.coordinator.IncrementTimeBy(time.Hour)
+	s.coordinator.CommitBlock(s.chainB)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.TimeoutPacket(packet))
+	s.Require().Equal(receivedCoin, s.appA().BankKeeper.GetBalance(s.chainA.GetContext(), orderer, receivedCoin.Denom))
+}
+
+func (s *IBCForwardTestSuite) TestForward_Failed() {
+	orderer := utils.TestAddress(1)
+	receivedCoin, ctx := s.sellWithForward(orderer, &types.IBCForward{
+		ChannelId: "channel-100",
+		Receiver:  s.chainB.SenderAccount.GetAddress().String(),
+		Timeout:   time.Hour,
+	})
+	s.Require().True(hasEvent(ctx, proto.MessageName(&types.EventIBCForwardFailed{})))
+	_, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	s.Require().Error(err)
+
+	// The orderer keeps the coin when the transfer couldn't be initiated.
+	s.Require().Equal(receivedCoin, s.appA().BankKeeper.GetBalance(s.chainA.GetContext(), orderer, receivedCoin.Denom))
+	s.Require().True(s.appA().BankKeeper.GetBalance(
+		s.chainA.GetContext(), transfertypes.GetEscrowAddress(transfertypes.PortID, s.path.EndpointA.ChannelID),
+		receivedCoin.Denom).IsZero())
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+
+	"shogun/x/liquidity/types"
+)
+
+// RegisterICA registers an interchain account of the owner on the host chain
+// of the connection.
+// The packets of the account's channel are routed to the liquidity module, so
+// that the results of the requests sent through it are recorded.
+func (k Keeper) RegisterICA(ctx sdk.Context, msg *types.MsgRegisterICA) error {
+	return k.icaControllerKeeper.RegisterInterchainAccount(ctx, msg.ConnectionId, msg.Owner, msg.Version)
+}
+
+// ICAMMOrder sends a MsgMMOrder to the host chain to be executed by the
+// owner's interchain account, and records the request.
+func (k Keeper) ICAMMOrder(ctx sdk.Context, msg *types.MsgICAMMOrder) (types.ICARequest, error) {
+	return k.sendICATx(ctx, msg.GetOwner(), msg.ConnectionId, msg.PairId, msg.Timeout, func(ica string) sdk.Msg {
+		return msg.MMOrderMsg(ica)
+	})
+}
+
+// ICACancelMMOrder sends a MsgCancelMMOrder to the host chain to be executed
+// by the owner's interchain account, and records the request.
+func (k Keeper) ICACancelMMOrder(ctx sdk.Context, msg *types.MsgICACancelMMOrder) (types.ICARequest, error) {
+	return k.sendICATx(ctx, msg.GetOwner(), msg.ConnectionId, msg.PairId, msg.Timeout, func(ica string) sdk.Msg {
+		return msg.CancelMMOrderMsg(ica)
+	})
+}
+
+// sendICATx sends the message made by hostMsg with the owner's interchain
+// account address as a transaction to the host chain, and records the
+// pending request.
+func (k Keeper) sendICATx(
+	ctx sdk.Context, owner sdk.AccAddress, connectionId string, pairId uint64,
+	timeout time.Duration, hostMsg func(ica string) sdk.Msg) (types.ICARequest, error) {
+	portId, err := icatypes.NewControllerPortID(owner.String())
+	if err != nil {
+		return types.ICARequest{}, err
+	}
+	ica, found := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionId, portId)
+	if !found {
+		return types.ICARequest{}, sdkerrors.Wrapf(
+			types.ErrICANotFound, "owner %s on connection %s", owner, connectionId)
+	}
+	channelId, found := k.icaControllerKeeper.GetActiveChannelID(ctx, connectionId, portId)
+	if !found {
+		return types.ICARequest{}, sdkerrors.Wrapf(
+			types.ErrICANotFound, "no active channel for owner %s on connection %s", owner, connectionId)
+	}
+
+	msg := hostMsg(ica)
+	data, err := icatypes.SerializeCosmosTx(k.cdc, []proto.Message{msg})
+	if err != nil {
+		return types.ICARequest{}, err
+	}
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, nil, connectionId, portId, packetData, timeoutTimestamp)
+	if err != nil {
+		return types.ICARequest{}, err
+	}
+
+	req := types.NewICARequest(channelId, sequence, owner, connectionId, msg, pairId)
+	k.SetICARequest(ctx, req)
+	return req, nil
+}
+
+// OnICAAcknowledgementPacket records the result of the ICA request of the
+// packet, decoding the ids of the orders made or canceled on the host chain
+// from the acknowledgement.
+func (k Keeper) OnICAAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	req, found := k.GetICARequest(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		// The packet wasn't sent through the liquidity module.
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := icatypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
+	}
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		orderIds, err := k.decodeICAOrderIds(req, resp.Result)
+		if err != nil {
+			// Returning the error would leave the request pending forever,
+			// so the request is recorded as failed instead.
+			req.Status = types.ICARequestStatusFailed
+			req.Error = err.Error()
+			break
+		}
+		req.Status = types.ICARequestStatusSucceeded
+		req.OrderIds = orderIds
+	case *channeltypes.Acknowledgement_Error:
+		req.Status = types.ICARequestStatusFailed
+		req.Error = resp.Error
+	}
+	k.SetICARequest(ctx, req)
+	return nil
+}
+
+// OnICATimeoutPacket records the ICA request of the packet as timed out.
+// The channel of the interchain account is closed on timeout, so the account
+// must be registered again to be used.
+func (k Keeper) OnICATimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	req, found := k.GetICARequest(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+	req.Status = types.ICARequestStatusTimedOut
+	k.SetICARequest(ctx, req)
+	return nil
+}
+
+// decodeICAOrderIds decodes the ids of the orders made or canceled from the
+// result of the ICA request's transaction.
+func (k Keeper) decodeICAOrderIds(req types.ICARequest, result []byte) ([]uint64, error) {
+	var txMsgData sdk.TxMsgData
+	if err := k.cdc.Unmarshal(result, &txMsgData); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 tx message data: %v", err)
+	}
+	if len(txMsgData.MsgResponses) != 1 {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrUnknownRequest, "expected 1 msg response, got %d", len(txMsgData.MsgResponses))
+	}
+	msgResp := txMsgData.MsgResponses[0]
+	switch req.MsgTypeUrl {
+	case sdk.MsgTypeURL(&types.MsgMMOrder{}):
+		var resp types.MsgMMOrderResponse
+		if err := k.cdc.Unmarshal(msgResp.Value, &resp); err != nil {
+			return nil, err
+		}
+		return resp.OrderIds, nil
+	case sdk.MsgTypeURL(&types.MsgCancelMMOrder{}):
+		var resp types.MsgCancelMMOrderResponse
+		if err := k.cdc.Unmarshal(msgResp.Value, &resp); err != nil {
+			return nil, err
+		}
+		return resp.CanceledOrderIds, nil
+	default:
+		return nil, fmt.Errorf("unexpected msg type url: %s", req.MsgTypeUrl)
+	}
+}
@@ -3,7 +3,10 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"shogun/x/liquidity/types"
 )
@@ -14,6 +17,9 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "pool-coin-escrow", PoolCoinEscrowInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "remaining-offer-coin-escrow", RemainingOfferCoinEscrowInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "pool-status", PoolStatusInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "mm-order-index", MMOrderIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "last-order-id", LastOrderIdInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pool-coin-supply", PoolCoinSupplyInvariant(k))
 }
 
 // AllInvariants returns a combined invariant of the liquidity module.
@@ -24,6 +30,9 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			PoolCoinEscrowInvariant,
 			RemainingOfferCoinEscrowInvariant,
 			PoolStatusInvariant,
+			MMOrderIndexInvariant,
+			LastOrderIdInvariant,
+			PoolCoinSupplyInvariant,
 		} {
 			res, stop := inv(k)(ctx)
 			if stop {
@@ -108,8 +117,10 @@ func RemainingOfferCoinEscrowInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
-// PoolStatusInvariant checks that the pools with zero pool coin supply have
-// been marked as disabled.
+// PoolStatusInvariant checks that the pools with zero pool coin supply or
+// depleted reserves have been marked as disabled.
+// The converse isn't checked, since anyone can send coins to a disabled
+// pool's reserve address, which makes the pool no longer depleted.
 func PoolStatusInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var (
@@ -118,8 +129,9 @@ func PoolStatusInvariant(k Keeper) sdk.Invariant {
 		)
 		_ = k.IterateAllPools(ctx, func(pool types.Pool) (stop bool, err error) {
 			if !pool.Disabled {
+				rx, ry := k.GetPoolBalances(ctx, pool)
 				ps := k.GetPoolCoinSupply(ctx, pool)
-				if ps.IsZero() {
+				if pool.AMMPool(rx.Amount, ry.Amount, ps).IsDepleted() {
 					count++
 					msg += fmt.Sprintf("\tpool %d should be disabled, but not\n", pool.Id)
 				}
@@ -133,3 +145,132 @@ func PoolStatusInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// MMOrderIndexInvariant checks that market making order indexes only refer
+// to existing market making orders of the same orderer and pair.
+func MMOrderIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			count int
+			msg   string
+		)
+		_ = k.IterateAllMMOrderIndexes(ctx, func(index types.MMOrderIndex) (stop bool, err error) {
+			for _, orderId := range index.OrderIds {
+				order, found := k.GetOrder(ctx, index.PairId, orderId)
+				switch {
+				case !found:
+					count++
+					msg += fmt.Sprintf("\tindex of %s in pair %d refers to non-existent order %d\n",
+						index.Orderer, index.PairId, orderId)
+				case order.Type != types.OrderTypeMM || order.Orderer != index.Orderer:
+					count++
+					msg += fmt.Sprintf("\tindex of %s in pair %d refers to order %d of %s with type %s\n",
+						index.Orderer, index.PairId, orderId, order.Orderer, order.Type)
+				}
+			}
+			return false, nil
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "mm-order-index",
+			fmt.Sprintf("%d invalid market making order index reference(s) found\n%s", count, msg),
+		), broken
+	}
+}
+
+// LastOrderIdInvariant checks that each pair's last order id is greater or
+// equal than the ids of all orders in the pair.
+func LastOrderIdInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			count int
+			msg   string
+		)
+		_ = k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
+			_ = k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
+				if order.Id > pair.LastOrderId {
+					count++
+					msg += fmt.Sprintf("\tpair %d has order %d, which is greater than last order id %d\n",
+						pair.Id, order.Id, pair.LastOrderId)
+				}
+				return false, nil
+			})
+			return false, nil
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "last-order-id",
+			fmt.Sprintf("%d order(s) with id greater than last order id found\n%s", count, msg),
+		), broken
+	}
+}
+
+// PoolCoinSupplyInvariant checks that the total supply of each pool's pool
+// coin equals the pool coins held by accounts other than the global escrow
+// address plus the pool coins escrowed by pending withdraw requests.
+// The global escrow address is blocked from receiving coins by sends, so it
+// only holds the escrowed pool coins.
+func PoolCoinSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pending := map[string]math.Int{}
+		_ = k.IterateAllWithdrawRequests(ctx, func(req types.WithdrawRequest) (stop bool, err error) {
+			if req.Status == types.RequestStatusNotExecuted {
+				amt, ok := pending[req.PoolCoin.Denom]
+				if !ok {
+					amt = math.ZeroInt()
+				}
+				pending[req.PoolCoin.Denom] = amt.Add(req.PoolCoin.Amount)
+			}
+			return false, nil
+		})
+
+		var (
+			count int
+			msg   string
+		)
+		_ = k.IterateAllPools(ctx, func(pool types.Pool) (stop bool, err error) {
+			held, err := k.poolCoinHeld(ctx, pool.PoolCoinDenom)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tpool %d holders can't be queried: %v\n", pool.Id, err)
+				return false, nil
+			}
+			expected := held
+			if amt, ok := pending[pool.PoolCoinDenom]; ok {
+				expected = expected.Add(amt)
+			}
+			if ps := k.GetPoolCoinSupply(ctx, pool); !ps.Equal(expected) {
+				count++
+				msg += fmt.Sprintf("\tpool %d has pool coin supply %s, but %s is outstanding\n",
+					pool.Id, ps, expected)
+			}
+			return false, nil
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "pool-coin-supply",
+			fmt.Sprintf("%d pool(s) with mismatching pool coin supply found\n%s", count, msg),
+		), broken
+	}
+}
+
+// poolCoinHeld returns the amount of the pool coin held by accounts other
+// than the global escrow address.
+// Only the holders of the pool coin are iterated, using the bank module's
+// denom owners index.
+func (k Keeper) poolCoinHeld(ctx sdk.Context, poolCoinDenom string) (math.Int, error) {
+	resp, err := k.bankKeeper.DenomOwners(sdk.WrapSDKContext(ctx), &banktypes.QueryDenomOwnersRequest{
+		Denom:      poolCoinDenom,
+		Pagination: &query.PageRequest{Limit: query.MaxLimit},
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+	held := math.ZeroInt()
+	for _, owner := range resp.DenomOwners {
+		if owner.Address != types.GlobalEscrowAddress.String() {
+			held = held.Add(owner.Balance.Amount)
+		}
+	}
+	return held, nil
+}
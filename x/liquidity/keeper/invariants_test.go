@@ -1,91 +1,198 @@
 package keeper_test
 
-// import (
-// 	utils "shogun/types"
-// 	"shogun/x/liquidity/keeper"
-// )
-
-// func (s *KeeperTestSuite) TestDepositCoinsEscrowInvariant() {
-// 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
-// 	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
-
-// 	req := s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
-// 	_, broken := keeper.DepositCoinsEscrowInvariant(s.keeper)(s.ctx)
-// 	s.Require().False(broken)
-
-// 	oldReq := req
-// 	req.DepositCoins = utils.ParseCoins("2000000denom1,2000000denom2")
-// 	s.keeper.SetDepositRequest(s.ctx, req)
-// 	_, broken = keeper.DepositCoinsEscrowInvariant(s.keeper)(s.ctx)
-// 	s.Require().True(broken)
-
-// 	req = oldReq
-// 	s.keeper.SetDepositRequest(s.ctx, req)
-// 	s.nextBlock()
-// 	_, broken = keeper.DepositCoinsEscrowInvariant(s.keeper)(s.ctx)
-// 	s.Require().False(broken)
-// }
-
-// func (s *KeeperTestSuite) TestPoolCoinEscrowInvariant() {
-// 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
-// 	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
-
-// 	s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
-// 	s.nextBlock()
-
-// 	req := s.withdraw(s.addr(1), pool.Id, utils.ParseCoin("1000000pool1"))
-// 	_, broken := keeper.PoolCoinEscrowInvariant(s.keeper)(s.ctx)
-// 	s.Require().False(broken)
-
-// 	oldReq := req
-// 	req.PoolCoin = utils.ParseCoin("2000000pool1")
-// 	s.keeper.SetWithdrawRequest(s.ctx, req)
-// 	_, broken = keeper.PoolCoinEscrowInvariant(s.keeper)(s.ctx)
-// 	s.Require().True(broken)
-
-// 	req = oldReq
-// 	s.keeper.SetWithdrawRequest(s.ctx, req)
-// 	s.nextBlock()
-// 	_, broken = keeper.PoolCoinEscrowInvariant(s.keeper)(s.ctx)
-// 	s.Require().False(broken)
-// }
-
-// func (s *KeeperTestSuite) TestRemainingOfferCoinEscrowInvariant() {
-// 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
-
-// 	order := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), newInt(1000000), 0, true)
-// 	_, broken := keeper.RemainingOfferCoinEscrowInvariant(s.keeper)(s.ctx)
-// 	s.Require().False(broken)
-
-// 	oldOrder := order
-// 	order.RemainingOfferCoin = utils.ParseCoin("2000000denom1")
-// 	s.keeper.SetOrder(s.ctx, order)
-// 	_, broken = keeper.RemainingOfferCoinEscrowInvariant(s.keeper)(s.ctx)
-// 	s.Require().True(broken)
-
-// 	order = oldOrder
-// 	s.keeper.SetOrder(s.ctx, order)
-// 	s.nextBlock()
-// 	_, broken = keeper.RemainingOfferCoinEscrowInvariant(s.keeper)(s.ctx)
-// 	s.Require().False(broken)
-// }
-
-// func (s *KeeperTestSuite) TestPoolStatusInvariant() {
-// 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
-// 	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
-
-// 	_, broken := keeper.PoolStatusInvariant(s.keeper)(s.ctx)
-// 	s.Require().False(broken)
-
-// 	s.withdraw(s.addr(0), pool.Id, s.getBalance(s.addr(0), pool.PoolCoinDenom))
-// 	s.nextBlock()
-
-// 	_, broken = keeper.PoolStatusInvariant(s.keeper)(s.ctx)
-// 	s.Require().False(broken)
-
-// 	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
-// 	pool.Disabled = false
-// 	s.keeper.SetPool(s.ctx, pool)
-// 	_, broken = keeper.PoolStatusInvariant(s.keeper)(s.ctx)
-// 	s.Require().True(broken)
-// }
+import (
+	"time"
+
+	utils "shogun/types"
+	"shogun/x/liquidity/keeper"
+	"shogun/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestDepositCoinsEscrowInvariant() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	req := s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	_, broken := keeper.DepositCoinsEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	oldReq := req
+	req.DepositCoins = utils.ParseCoins("2000000denom1,2000000denom2")
+	s.keeper.SetDepositRequest(s.ctx, req)
+	_, broken = keeper.DepositCoinsEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+
+	req = oldReq
+	s.keeper.SetDepositRequest(s.ctx, req)
+	s.nextBlock()
+	_, broken = keeper.DepositCoinsEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+}
+
+func (s *KeeperTestSuite) TestPoolCoinEscrowInvariant() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.nextBlock()
+
+	req := s.withdraw(s.addr(1), pool.Id, utils.ParseCoin("1000000pool1"))
+	_, broken := keeper.PoolCoinEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	oldReq := req
+	req.PoolCoin = utils.ParseCoin("2000000pool1")
+	s.keeper.SetWithdrawRequest(s.ctx, req)
+	_, broken = keeper.PoolCoinEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+
+	req = oldReq
+	s.keeper.SetWithdrawRequest(s.ctx, req)
+	s.nextBlock()
+	_, broken = keeper.PoolCoinEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+}
+
+func (s *KeeperTestSuite) TestRemainingOfferCoinEscrowInvariant() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	order := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), newInt(1000000), 0, true)
+	_, broken := keeper.RemainingOfferCoinEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	oldOrder := order
+	order.RemainingOfferCoin = utils.ParseCoin("2000000denom1")
+	s.keeper.SetOrder(s.ctx, order)
+	_, broken = keeper.RemainingOfferCoinEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+
+	order = oldOrder
+	s.keeper.SetOrder(s.ctx, order)
+	s.nextBlock()
+	_, broken = keeper.RemainingOfferCoinEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+}
+
+func (s *KeeperTestSuite) TestPoolStatusInvariant() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	_, broken := keeper.PoolStatusInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	s.withdraw(s.addr(0), pool.Id, s.getBalance(s.addr(0), pool.PoolCoinDenom))
+	s.nextBlock()
+
+	_, broken = keeper.PoolStatusInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// Coins sent to a disabled pool's reserve address don't enable it.
+	s.fundAddr(pool.GetReserveAddress(), utils.ParseCoins("1000000denom1,1000000denom2"))
+	_, broken = keeper.PoolStatusInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	pool.Disabled = false
+	s.keeper.SetPool(s.ctx, pool)
+	_, broken = keeper.PoolStatusInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestPoolStatusInvariant_DepletedButNotDisabled() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	// The pool coin supply is positive, but the pool has no denom1 reserve.
+	s.Require().NoError(s.app.BankKeeper.SendCoins(
+		s.ctx, pool.GetReserveAddress(), s.addr(1), utils.ParseCoins("1000000denom1")))
+	_, broken := keeper.PoolStatusInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestMMOrderIndexInvariant() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	orders := s.mmOrder(
+		s.addr(1), pair.Id,
+		utils.ParseDec("1.1"), utils.ParseDec("1.05"), newInt(100000),
+		utils.ParseDec("0.95"), utils.ParseDec("0.9"), newInt(100000),
+		time.Hour, true)
+	_, broken := keeper.MMOrderIndexInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// Deleting an order also removes it from the index.
+	s.keeper.DeleteOrder(s.ctx, orders[0])
+	_, broken = keeper.MMOrderIndexInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+	index, found := s.keeper.GetMMOrderIndex(s.ctx, s.addr(1), pair.Id)
+	s.Require().True(found)
+	s.Require().NotContains(index.OrderIds, orders[0].Id)
+
+	// The index refers to an order of another orderer.
+	order := s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.9"), newInt(10000), time.Hour, true)
+	index.OrderIds = append(index.OrderIds, order.Id)
+	s.keeper.SetMMOrderIndex(s.ctx, index)
+	_, broken = keeper.MMOrderIndexInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+
+	// The index refers to a non-existent order.
+	index.OrderIds = []uint64{orders[1].Id, 100}
+	s.keeper.SetMMOrderIndex(s.ctx, index)
+	_, broken = keeper.MMOrderIndexInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestLastOrderIdInvariant() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	order := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), newInt(1000000), 0, true)
+	_, broken := keeper.LastOrderIdInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	pair.LastOrderId = order.Id - 1
+	s.keeper.SetPair(s.ctx, pair)
+	_, broken = keeper.LastOrderIdInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestPoolCoinSupplyInvariant() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.nextBlock()
+	_, broken := keeper.PoolCoinSupplyInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// Pool coins of a pending withdraw request are escrowed.
+	req := s.withdraw(s.addr(1), pool.Id, utils.ParseCoin("500000000000pool1"))
+	_, broken = keeper.PoolCoinSupplyInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// The escrow doesn't hold enough pool coins for pending requests.
+	req.PoolCoin = utils.ParseCoin("500000000001pool1")
+	s.keeper.SetWithdrawRequest(s.ctx, req)
+	_, broken = keeper.PoolCoinSupplyInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+	req.PoolCoin = utils.ParseCoin("500000000000pool1")
+
+	req.Status = types.RequestStatusNotExecuted
+	s.keeper.SetWithdrawRequest(s.ctx, req)
+	s.nextBlock()
+	_, broken = keeper.PoolCoinSupplyInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// Pool coins minted into the escrow without a withdraw request inflate
+	// the supply.
+	poolCoins := utils.ParseCoins("1000pool1")
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, types.ModuleName, poolCoins))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(
+		s.ctx, s.app.AccountKeeper.GetModuleAddress(types.ModuleName), types.GlobalEscrowAddress, poolCoins))
+	_, broken = keeper.PoolCoinSupplyInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestGlobalEscrowAddressBlocked() {
+	s.Require().True(s.app.BankKeeper.BlockedAddr(types.GlobalEscrowAddress))
+}
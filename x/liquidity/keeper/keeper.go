@@ -6,7 +6,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"shogun/x/liquidity/types"
@@ -14,47 +13,84 @@ import (
 
 // Keeper of the liquidity store.
 type Keeper struct {
-	cdc        codec.BinaryCodec
-	storeKey   store.Key
-	paramSpace paramstypes.Subspace
+	cdc      codec.BinaryCodec
+	storeKey store.Key
 
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
+	accountKeeper       types.AccountKeeper
+	bankKeeper          types.BankKeeper
+	stakingKeeper       types.StakingKeeper
+	transferKeeper      types.TransferKeeper
+	icaControllerKeeper types.ICAControllerKeeper
+
+	// authority is the address allowed to update the module parameters,
+	// which is the governance module account by default.
+	authority string
+
+	// emitLegacyEvents tells whether to emit the deprecated events with
+	// string attributes along with the typed events.
+	emitLegacyEvents bool
 }
 
 // NewKeeper creates a new liquidity Keeper instance.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey store.Key,
-	paramSpace paramstypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
+	transferKeeper types.TransferKeeper,
+	icaControllerKeeper types.ICAControllerKeeper,
+	authority string,
 ) Keeper {
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid authority address: %w", err))
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		paramSpace:    paramSpace,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
+		cdc:                 cdc,
+		storeKey:            storeKey,
+		accountKeeper:       accountKeeper,
+		bankKeeper:          bankKeeper,
+		stakingKeeper:       stakingKeeper,
+		transferKeeper:      transferKeeper,
+		icaControllerKeeper: icaControllerKeeper,
+		authority:           authority,
+		emitLegacyEvents:    true,
 	}
 }
 
+// SetEmitLegacyEvents sets whether to emit the deprecated events with string
+// attributes along with the typed events.
+// The legacy events are emitted by default, and will be removed in the next
+// release.
+func (k *Keeper) SetEmitLegacyEvents(emit bool) {
+	k.emitLegacyEvents = emit
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetParams returns the parameters for the liquidity module.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &params)
 	return
 }
 
 // SetParams sets the parameters for the liquidity module.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"shogun/x/liquidity/types"
 )
@@ -100,11 +102,17 @@ func (m msgServer) MarketOrder(goCtx context.Context, msg *types.MsgMarketOrder)
 func (m msgServer) MMOrder(goCtx context.Context, msg *types.MsgMMOrder) (*types.MsgMMOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if _, err := m.Keeper.MMOrder(ctx, msg); err != nil {
+	orders, err := m.Keeper.MMOrder(ctx, msg)
+	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgMMOrderResponse{}, nil
+	orderIds := make([]uint64, len(orders))
+	for i, order := range orders {
+		orderIds[i] = order.Id
+	}
+
+	return &types.MsgMMOrderResponse{OrderIds: orderIds}, nil
 }
 
 // CancelOrder defines a method to cancel an order.
@@ -133,9 +141,64 @@ func (m msgServer) CancelAllOrders(goCtx context.Context, msg *types.MsgCancelAl
 func (m msgServer) CancelMMOrder(goCtx context.Context, msg *types.MsgCancelMMOrder) (*types.MsgCancelMMOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if _, err := m.Keeper.CancelMMOrder(ctx, msg); err != nil {
+	canceledOrderIds, err := m.Keeper.CancelMMOrder(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelMMOrderResponse{CanceledOrderIds: canceledOrderIds}, nil
+}
+
+// RegisterICA defines a method to register an interchain account for
+// remote market making.
+func (m msgServer) RegisterICA(goCtx context.Context, msg *types.MsgRegisterICA) (*types.MsgRegisterICAResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RegisterICA(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterICAResponse{}, nil
+}
+
+// ICAMMOrder defines a method to make a MM(market making) order through an
+// interchain account.
+func (m msgServer) ICAMMOrder(goCtx context.Context, msg *types.MsgICAMMOrder) (*types.MsgICAMMOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	req, err := m.Keeper.ICAMMOrder(ctx, msg)
+	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgCancelMMOrderResponse{}, nil
+	return &types.MsgICAMMOrderResponse{ChannelId: req.ChannelId, Sequence: req.Sequence}, nil
+}
+
+// ICACancelMMOrder defines a method to cancel all previous market making
+// orders through an interchain account.
+func (m msgServer) ICACancelMMOrder(goCtx context.Context, msg *types.MsgICACancelMMOrder) (*types.MsgICACancelMMOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	req, err := m.Keeper.ICACancelMMOrder(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgICACancelMMOrderResponse{ChannelId: req.ChannelId, Sequence: req.Sequence}, nil
+}
+
+// UpdateParams defines a method to update the module parameters.
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	m.Keeper.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -53,16 +53,27 @@ func (k Keeper) CreatePair(ctx sdk.Context, msg *types.MsgCreatePair) (types.Pai
 	k.SetPairLookupIndex(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom, pair.Id)
 	k.SetPairLookupIndex(ctx, pair.QuoteCoinDenom, pair.BaseCoinDenom, pair.Id)
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCreatePair,
-			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
-			sdk.NewAttribute(types.AttributeKeyBaseCoinDenom, msg.BaseCoinDenom),
-			sdk.NewAttribute(types.AttributeKeyQuoteCoinDenom, msg.QuoteCoinDenom),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyEscrowAddress, pair.EscrowAddress),
-		),
-	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreatePair{
+		Creator:        msg.Creator,
+		PairId:         pair.Id,
+		BaseCoinDenom:  msg.BaseCoinDenom,
+		QuoteCoinDenom: msg.QuoteCoinDenom,
+		EscrowAddress:  pair.EscrowAddress,
+	}); err != nil {
+		return types.Pair{}, err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCreatePair,
+				sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+				sdk.NewAttribute(types.AttributeKeyBaseCoinDenom, msg.BaseCoinDenom),
+				sdk.NewAttribute(types.AttributeKeyQuoteCoinDenom, msg.QuoteCoinDenom),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyEscrowAddress, pair.EscrowAddress),
+			),
+		})
+	}
 
 	return pair, nil
 }
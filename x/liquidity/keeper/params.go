@@ -5,27 +5,21 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-
-	"shogun/x/liquidity/types"
 )
 
 // GetBatchSize returns the current batch size parameter.
 func (k Keeper) GetBatchSize(ctx sdk.Context) (batchSize uint32) {
-	k.paramSpace.Get(ctx, types.KeyBatchSize, &batchSize)
-	return
+	return k.GetParams(ctx).BatchSize
 }
 
 // GetTickPrecision returns the current tick precision parameter.
 func (k Keeper) GetTickPrecision(ctx sdk.Context) (tickPrec uint32) {
-	k.paramSpace.Get(ctx, types.KeyTickPrecision, &tickPrec)
-	return
+	return k.GetParams(ctx).TickPrecision
 }
 
 // GetFeeCollector returns the current fee collector address parameter.
 func (k Keeper) GetFeeCollector(ctx sdk.Context) sdk.AccAddress {
-	var feeCollectorAddr string
-	k.paramSpace.Get(ctx, types.KeyFeeCollectorAddress, &feeCollectorAddr)
-	addr, err := sdk.AccAddressFromBech32(feeCollectorAddr)
+	addr, err := sdk.AccAddressFromBech32(k.GetParams(ctx).FeeCollectorAddress)
 	if err != nil {
 		panic(err)
 	}
@@ -34,9 +28,7 @@ func (k Keeper) GetFeeCollector(ctx sdk.Context) sdk.AccAddress {
 
 // GetDustCollector returns the current dust collector address parameter.
 func (k Keeper) GetDustCollector(ctx sdk.Context) sdk.AccAddress {
-	var dustCollectorAddr string
-	k.paramSpace.Get(ctx, types.KeyDustCollectorAddress, &dustCollectorAddr)
-	addr, err := sdk.AccAddressFromBech32(dustCollectorAddr)
+	addr, err := sdk.AccAddressFromBech32(k.GetParams(ctx).DustCollectorAddress)
 	if err != nil {
 		panic(err)
 	}
@@ -46,87 +38,97 @@ func (k Keeper) GetDustCollector(ctx sdk.Context) sdk.AccAddress {
 // GetMinInitialPoolCoinSupply returns the current minimum pool coin supply
 // parameter.
 func (k Keeper) GetMinInitialPoolCoinSupply(ctx sdk.Context) (i math.Int) {
-	k.paramSpace.Get(ctx, types.KeyMinInitialPoolCoinSupply, &i)
-	return
+	return k.GetParams(ctx).MinInitialPoolCoinSupply
 }
 
 // GetPairCreationFee returns the current pair creation fee parameter.
 func (k Keeper) GetPairCreationFee(ctx sdk.Context) (fee sdk.Coins) {
-	k.paramSpace.Get(ctx, types.KeyPairCreationFee, &fee)
-	return
+	return k.GetParams(ctx).PairCreationFee
 }
 
 // GetPoolCreationFee returns the current pool creation fee parameter.
 func (k Keeper) GetPoolCreationFee(ctx sdk.Context) (fee sdk.Coins) {
-	k.paramSpace.Get(ctx, types.KeyPoolCreationFee, &fee)
-	return
+	return k.GetParams(ctx).PoolCreationFee
 }
 
 // GetMinInitialDepositAmount returns the current minimum initial deposit
 // amount parameter.
 func (k Keeper) GetMinInitialDepositAmount(ctx sdk.Context) (amt math.Int) {
-	k.paramSpace.Get(ctx, types.KeyMinInitialDepositAmount, &amt)
-	return
+	return k.GetParams(ctx).MinInitialDepositAmount
 }
 
 // GetMaxPriceLimitRatio returns the current maximum price limit ratio
 // parameter.
 func (k Keeper) GetMaxPriceLimitRatio(ctx sdk.Context) (ratio math.LegacyDec) {
-	k.paramSpace.Get(ctx, types.KeyMaxPriceLimitRatio, &ratio)
-	return
+	return k.GetParams(ctx).MaxPriceLimitRatio
 }
 
 // GetMaxNumMarketMakingOrderTicks returns the current maximum number of
 // market making order ticks.
 func (k Keeper) GetMaxNumMarketMakingOrderTicks(ctx sdk.Context) (i uint32) {
-	k.paramSpace.Get(ctx, types.KeyMaxNumMarketMakingOrderTicks, &i)
-	return
+	return k.GetParams(ctx).MaxNumMarketMakingOrderTicks
 }
 
 // GetMaxOrderLifespan returns the current maximum order lifespan
 // parameter.
 func (k Keeper) GetMaxOrderLifespan(ctx sdk.Context) (maxLifespan time.Duration) {
-	k.paramSpace.Get(ctx, types.KeyMaxOrderLifespan, &maxLifespan)
-	return
+	return k.GetParams(ctx).MaxOrderLifespan
 }
 
 // GetWithdrawFeeRate returns the current withdraw fee rate parameter.
 func (k Keeper) GetWithdrawFeeRate(ctx sdk.Context) (feeRate math.LegacyDec) {
-	k.paramSpace.Get(ctx, types.KeyWithdrawFeeRate, &feeRate)
-	return
+	return k.GetParams(ctx).WithdrawFeeRate
 }
 
 // GetDepositExtraGas returns the current deposit extra gas parameter.
 func (k Keeper) GetDepositExtraGas(ctx sdk.Context) (gas sdk.Gas) {
-	k.paramSpace.Get(ctx, types.KeyDepositExtraGas, &gas)
-	return
+	return k.GetParams(ctx).DepositExtraGas
 }
 
 // GetWithdrawExtraGas returns the current withdraw extra gas parameter.
 func (k Keeper) GetWithdrawExtraGas(ctx sdk.Context) (gas sdk.Gas) {
-	k.paramSpace.Get(ctx, types.KeyWithdrawExtraGas, &gas)
-	return
+	return k.GetParams(ctx).WithdrawExtraGas
 }
 
 // GetOrderExtraGas returns the current order extra gas parameter.
 func (k Keeper) GetOrderExtraGas(ctx sdk.Context) (gas sdk.Gas) {
-	k.paramSpace.Get(ctx, types.KeyOrderExtraGas, &gas)
-	return
+	return k.GetParams(ctx).OrderExtraGas
 }
 
 // SetMaxNumMarketMakingOrderTicks sets max num market making order ticks
 func (k Keeper) SetMaxNumMarketMakingOrderTicks(ctx sdk.Context, input uint32) {
-	k.paramSpace.Set(ctx, types.KeyMaxNumMarketMakingOrderTicks, input)
+	params := k.GetParams(ctx)
+	params.MaxNumMarketMakingOrderTicks = input
+	k.SetParams(ctx, params)
 }
 
 // GetMaxNumActivePoolsPerPair returns the current maximum number of active
 // pools per pair.
 func (k Keeper) GetMaxNumActivePoolsPerPair(ctx sdk.Context) (i uint32) {
-	k.paramSpace.Get(ctx, types.KeyMaxNumActivePoolsPerPair, &i)
-	return
+	return k.GetParams(ctx).MaxNumActivePoolsPerPair
 }
 
 // SetMaxNumActivePoolsPerPair sets the maximum number of active pools per pair.
 func (k Keeper) SetMaxNumActivePoolsPerPair(ctx sdk.Context, i uint32) {
-	k.paramSpace.Set(ctx, types.KeyMaxNumActivePoolsPerPair, i)
+	params := k.GetParams(ctx)
+	params.MaxNumActivePoolsPerPair = i
+	k.SetParams(ctx, params)
+}
+
+// GetFinishedRecordRetentionBlocks returns the current number of blocks to
+// keep finished orders and requests in the store.
+func (k Keeper) GetFinishedRecordRetentionBlocks(ctx sdk.Context) (i uint32) {
+	return k.GetParams(ctx).FinishedRecordRetentionBlocks
+}
+
+// GetFeeDenoms returns the current denoms which transaction fees can be paid
+// in other than the native coin.
+func (k Keeper) GetFeeDenoms(ctx sdk.Context) (denoms []string) {
+	return k.GetParams(ctx).FeeDenoms
+}
+
+// GetMinFeePoolReserve returns the current minimum native coin reserve of a
+// pool to value fees by its price.
+func (k Keeper) GetMinFeePoolReserve(ctx sdk.Context) (amt math.Int) {
+	return k.GetParams(ctx).MinFeePoolReserve
 }
@@ -155,17 +155,29 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (types.Poo
 		return types.Pool{}, err
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCreatePool,
-			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
-			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyReserveAddress, pool.ReserveAddress),
-			sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, poolCoin.String()),
-		),
-	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreatePool{
+		Creator:        msg.Creator,
+		PairId:         msg.PairId,
+		PoolId:         pool.Id,
+		DepositCoins:   msg.DepositCoins,
+		ReserveAddress: pool.ReserveAddress,
+		MintedPoolCoin: poolCoin,
+	}); err != nil {
+		return types.Pool{}, err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCreatePool,
+				sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+				sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyReserveAddress, pool.ReserveAddress),
+				sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, poolCoin.String()),
+			),
+		})
+	}
 
 	return pool, nil
 }
@@ -266,17 +278,29 @@ func (k Keeper) CreateRangedPool(ctx sdk.Context, msg *types.MsgCreateRangedPool
 		return types.Pool{}, err
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCreateRangedPool,
-			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
-			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyReserveAddress, pool.ReserveAddress),
-			sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, poolCoin.String()),
-		),
-	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateRangedPool{
+		Creator:        msg.Creator,
+		PairId:         msg.PairId,
+		PoolId:         pool.Id,
+		DepositCoins:   msg.DepositCoins,
+		ReserveAddress: pool.ReserveAddress,
+		MintedPoolCoin: poolCoin,
+	}); err != nil {
+		return types.Pool{}, err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCreateRangedPool,
+				sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+				sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyReserveAddress, pool.ReserveAddress),
+				sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, poolCoin.String()),
+			),
+		})
+	}
 
 	return pool, nil
 }
@@ -331,15 +355,25 @@ func (k Keeper) Deposit(ctx sdk.Context, msg *types.MsgDeposit) (types.DepositRe
 
 	ctx.GasMeter().ConsumeGas(k.GetDepositExtraGas(ctx), "DepositExtraGas")
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeDeposit,
-			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
-			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
-		),
-	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDeposit{
+		Depositor:    msg.Depositor,
+		PoolId:       pool.Id,
+		RequestId:    req.Id,
+		DepositCoins: msg.DepositCoins,
+	}); err != nil {
+		return types.DepositRequest{}, err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeDeposit,
+				sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+			),
+		})
+	}
 
 	return req, nil
 }
@@ -379,15 +413,25 @@ func (k Keeper) Withdraw(ctx sdk.Context, msg *types.MsgWithdraw) (types.Withdra
 
 	ctx.GasMeter().ConsumeGas(k.GetWithdrawExtraGas(ctx), "WithdrawExtraGas")
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeWithdraw,
-			sdk.NewAttribute(types.AttributeKeyWithdrawer, msg.Withdrawer),
-			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyPoolCoin, msg.PoolCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
-		),
-	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWithdraw{
+		Withdrawer: msg.Withdrawer,
+		PoolId:     pool.Id,
+		RequestId:  req.Id,
+		PoolCoin:   msg.PoolCoin,
+	}); err != nil {
+		return types.WithdrawRequest{}, err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeWithdraw,
+				sdk.NewAttribute(types.AttributeKeyWithdrawer, msg.Withdrawer),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyPoolCoin, msg.PoolCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+			),
+		})
+	}
 
 	return req, nil
 }
@@ -402,11 +446,8 @@ func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest)
 		return nil
 	}
 
-	pair, _ := k.GetPair(ctx, pool.PairId)
-	rx, ry := k.getPoolBalances(ctx, pool, pair)
-	ps := k.GetPoolCoinSupply(ctx, pool)
-	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
-	if ammPool.IsDepleted() {
+	acceptedCoins, mintedPoolCoin, depleted := k.depositResult(ctx, pool, req.DepositCoins)
+	if depleted {
 		k.MarkPoolAsDisabled(ctx, pool)
 		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
 			return err
@@ -414,23 +455,19 @@ func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest)
 		return nil
 	}
 
-	ax, ay, pc := amm.Deposit(rx.Amount, ry.Amount, ps, req.DepositCoins.AmountOf(pair.QuoteCoinDenom), req.DepositCoins.AmountOf(pair.BaseCoinDenom))
-
-	if pc.IsZero() {
+	if mintedPoolCoin.IsZero() {
 		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
 			return err
 		}
 		return nil
 	}
 
-	mintedPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, pc)
 	mintingCoins := sdk.NewCoins(mintedPoolCoin)
 
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, mintingCoins); err != nil {
 		return err
 	}
 
-	acceptedCoins := sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
 	bulkOp := types.NewBulkSendCoinsOperation()
 	bulkOp.QueueSendCoins(types.GlobalEscrowAddress, pool.GetReserveAddress(), acceptedCoins)
 	bulkOp.QueueSendCoins(k.accountKeeper.GetModuleAddress(types.ModuleName), req.GetDepositor(), mintingCoins)
@@ -446,6 +483,24 @@ func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest)
 	return nil
 }
 
+// depositResult returns the coins accepted from the deposit coins and the
+// pool coin minted by depositing them to the pool.
+// The minted pool coin is zero if the pool is depleted.
+func (k Keeper) depositResult(
+	ctx sdk.Context, pool types.Pool, depositCoins sdk.Coins) (acceptedCoins sdk.Coins, mintedPoolCoin sdk.Coin, depleted bool) {
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
+	if ammPool.IsDepleted() {
+		return sdk.Coins{}, sdk.NewInt64Coin(pool.PoolCoinDenom, 0), true
+	}
+
+	ax, ay, pc := amm.Deposit(rx.Amount, ry.Amount, ps, depositCoins.AmountOf(pair.QuoteCoinDenom), depositCoins.AmountOf(pair.BaseCoinDenom))
+	acceptedCoins = sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
+	return acceptedCoins, sdk.NewCoin(pool.PoolCoinDenom, pc), false
+}
+
 // FinishDepositRequest refunds unhandled deposit coins and set request status.
 func (k Keeper) FinishDepositRequest(ctx sdk.Context, req types.DepositRequest, status types.RequestStatus) error {
 	if req.Status != types.RequestStatusNotExecuted { // sanity check
@@ -460,20 +515,35 @@ func (k Keeper) FinishDepositRequest(ctx sdk.Context, req types.DepositRequest,
 	}
 	req.SetStatus(status)
 	k.SetDepositRequest(ctx, req)
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeDepositResult,
-			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyDepositor, req.Depositor),
-			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(req.PoolId, 10)),
-			sdk.NewAttribute(types.AttributeKeyDepositCoins, req.DepositCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyAcceptedCoins, req.AcceptedCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundingCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, req.MintedPoolCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyStatus, req.Status.String()),
-		),
-	})
+	k.SetDepositRequestDeletionQueue(ctx, k.deletionHeight(ctx), req)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDepositResult{
+		RequestId:      req.Id,
+		Depositor:      req.Depositor,
+		PoolId:         req.PoolId,
+		DepositCoins:   req.DepositCoins,
+		AcceptedCoins:  req.AcceptedCoins,
+		RefundedCoins:  refundingCoins,
+		MintedPoolCoin: req.MintedPoolCoin,
+		Status:         req.Status,
+	}); err != nil {
+		return err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeDepositResult,
+				sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyDepositor, req.Depositor),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(req.PoolId, 10)),
+				sdk.NewAttribute(types.AttributeKeyDepositCoins, req.DepositCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyAcceptedCoins, req.AcceptedCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundingCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, req.MintedPoolCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyStatus, req.Status.String()),
+			),
+		})
+	}
 
 	return nil
 }
@@ -488,11 +558,8 @@ func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawReques
 		return nil
 	}
 
-	pair, _ := k.GetPair(ctx, pool.PairId)
-	rx, ry := k.getPoolBalances(ctx, pool, pair)
-	ps := k.GetPoolCoinSupply(ctx, pool)
-	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
-	if ammPool.IsDepleted() {
+	withdrawnCoins, ps, depleted := k.withdrawResult(ctx, pool, req.PoolCoin, k.GetWithdrawFeeRate(ctx))
+	if depleted {
 		k.MarkPoolAsDisabled(ctx, pool)
 		if err := k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed); err != nil {
 			return err
@@ -500,15 +567,13 @@ func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawReques
 		return nil
 	}
 
-	x, y := amm.Withdraw(rx.Amount, ry.Amount, ps, req.PoolCoin.Amount, k.GetWithdrawFeeRate(ctx))
-	if x.IsZero() && y.IsZero() {
+	if withdrawnCoins.IsZero() {
 		if err := k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed); err != nil {
 			return err
 		}
 		return nil
 	}
 
-	withdrawnCoins := sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, x), sdk.NewCoin(pair.BaseCoinDenom, y))
 	burningCoins := sdk.NewCoins(req.PoolCoin)
 
 	bulkOp := types.NewBulkSendCoinsOperation()
@@ -534,6 +599,23 @@ func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawReques
 	return nil
 }
 
+// withdrawResult returns the coins withdrawn by withdrawing the pool coin
+// from the pool at the fee rate, and the pool coin supply before withdrawing.
+// The withdrawn coins are zero if the pool is depleted.
+func (k Keeper) withdrawResult(
+	ctx sdk.Context, pool types.Pool, poolCoin sdk.Coin, feeRate math.LegacyDec) (withdrawnCoins sdk.Coins, ps math.Int, depleted bool) {
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ps = k.GetPoolCoinSupply(ctx, pool)
+	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
+	if ammPool.IsDepleted() {
+		return sdk.Coins{}, ps, true
+	}
+
+	x, y := amm.Withdraw(rx.Amount, ry.Amount, ps, poolCoin.Amount, feeRate)
+	return sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, x), sdk.NewCoin(pair.BaseCoinDenom, y)), ps, false
+}
+
 // FinishWithdrawRequest refunds unhandled pool coin and set request status.
 func (k Keeper) FinishWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest, status types.RequestStatus) error {
 	if req.Status != types.RequestStatusNotExecuted { // sanity check
@@ -549,19 +631,33 @@ func (k Keeper) FinishWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest
 	}
 	req.SetStatus(status)
 	k.SetWithdrawRequest(ctx, req)
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeWithdrawalResult,
-			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyWithdrawer, req.Withdrawer),
-			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(req.PoolId, 10)),
-			sdk.NewAttribute(types.AttributeKeyPoolCoin, req.PoolCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundingCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, req.WithdrawnCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyStatus, req.Status.String()),
-		),
-	})
+	k.SetWithdrawRequestDeletionQueue(ctx, k.deletionHeight(ctx), req)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWithdrawResult{
+		RequestId:      req.Id,
+		Withdrawer:     req.Withdrawer,
+		PoolId:         req.PoolId,
+		PoolCoin:       req.PoolCoin,
+		RefundedCoins:  refundingCoins,
+		WithdrawnCoins: req.WithdrawnCoins,
+		Status:         req.Status,
+	}); err != nil {
+		return err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeWithdrawalResult,
+				sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyWithdrawer, req.Withdrawer),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(req.PoolId, 10)),
+				sdk.NewAttribute(types.AttributeKeyPoolCoin, req.PoolCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundingCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, req.WithdrawnCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyStatus, req.Status.String()),
+			),
+		})
+	}
 
 	return nil
 }
@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -424,9 +428,32 @@ func (k Keeper) GetOrder(ctx sdk.Context, pairId, id uint64) (order types.Order,
 
 // SetOrder stores an order for the batch execution.
 func (k Keeper) SetOrder(ctx sdk.Context, order types.Order) {
+	// Only the status of an order changes after it's made, so the other
+	// field indexes are set only for new orders.
+	prev, found := k.GetOrder(ctx, order.PairId, order.Id)
+	switch {
+	case !found:
+		for _, field := range types.OrderIndexFields {
+			k.SetOrderFieldIndex(ctx, order, field)
+		}
+	case prev.Status != order.Status:
+		k.DeleteOrderFieldIndex(ctx, prev, types.OrderIndexFieldStatus)
+		k.SetOrderFieldIndex(ctx, order, types.OrderIndexFieldStatus)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz := types.MustMarshaOrder(k.cdc, order)
 	store.Set(types.GetOrderKey(order.PairId, order.Id), bz)
+	if order.Status.CanBeExpired() {
+		k.SetOrderExpiryIndex(ctx, order)
+	} else {
+		k.DeleteOrderExpiryIndex(ctx, order)
+	}
+	if order.Status.IsMatchable() {
+		k.SetOrdersByPriceIndex(ctx, order)
+	} else {
+		k.DeleteOrdersByPriceIndex(ctx, order)
+	}
 }
 
 func (k Keeper) SetOrderIndex(ctx sdk.Context, order types.Order) {
@@ -434,6 +461,29 @@ func (k Keeper) SetOrderIndex(ctx sdk.Context, order types.Order) {
 	store.Set(types.GetOrderIndexKey(order.GetOrderer(), order.PairId, order.Id), []byte{})
 }
 
+// SetOrderExpiryIndex stores an order expiry index, which is used to find
+// expired orders without iterating through all orders.
+func (k Keeper) SetOrderExpiryIndex(ctx sdk.Context, order types.Order) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOrderExpiryIndexKey(order.ExpireAt, order.PairId, order.Id), []byte{})
+}
+
+// SetOrderFieldIndex stores the indexes of an order by the value of the
+// field, which are used to filter orders within a pair or by an orderer.
+func (k Keeper) SetOrderFieldIndex(ctx sdk.Context, order types.Order, field types.OrderIndexField) {
+	store := ctx.KVStore(k.storeKey)
+	value := types.OrderIndexFieldValue(order, field)
+	store.Set(types.GetOrdersByFieldIndexKey(order.PairId, field, value, order.Id), []byte{})
+	store.Set(types.GetOrdersByOrdererFieldIndexKey(order.GetOrderer(), field, value, order.PairId, order.Id), []byte{})
+}
+
+// SetOrdersByPriceIndex stores an orders by price index, which is used to
+// iterate matchable orders within a price range.
+func (k Keeper) SetOrdersByPriceIndex(ctx sdk.Context, order types.Order) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOrdersByPriceIndexKey(order.PairId, order.Direction, order.Price, order.BatchId, order.Id), []byte{})
+}
+
 // IterateAllOrders iterates through all orders in the store and all
 // cb for each order.
 func (k Keeper) IterateAllOrders(ctx sdk.Context, cb func(order types.Order) (stop bool, err error)) error {
@@ -492,6 +542,82 @@ func (k Keeper) IterateOrdersByOrderer(ctx sdk.Context, orderer sdk.AccAddress,
 	return nil
 }
 
+// IterateExpiredOrders iterates through orders which have been expired at
+// the given time, in the order of their expiration time, and call cb on
+// each order.
+func (k Keeper) IterateExpiredOrders(ctx sdk.Context, t time.Time, cb func(order types.Order) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.OrderExpiryIndexKeyPrefix, sdk.PrefixEndBytes(types.GetOrderExpiryIndexKeyPrefix(t)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, pairId, orderId := types.ParseOrderExpiryIndexKey(iter.Key())
+		order, found := k.GetOrder(ctx, pairId, orderId)
+		if !found { // the index is always written and deleted along with the order
+			panic(fmt.Errorf("order not found for expiry index key %X", iter.Key()))
+		}
+		stop, err := cb(order)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateBuyOrdersOver iterates through matchable buy orders within the pair
+// whose price is greater than or equal to the given price, from the highest
+// price, and call cb on each order.
+func (k Keeper) IterateBuyOrdersOver(ctx sdk.Context, pairId uint64, price math.LegacyDec, cb func(order types.Order) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator(
+		types.GetOrdersByPriceIndexKeyPrefixWithPrice(pairId, types.OrderDirectionBuy, price),
+		sdk.PrefixEndBytes(types.GetOrdersByPriceIndexKeyPrefix(pairId, types.OrderDirectionBuy)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, _, _, orderId := types.ParseOrdersByPriceIndexKey(iter.Key())
+		order, found := k.GetOrder(ctx, pairId, orderId)
+		if !found { // the index is always written and deleted along with the order
+			panic(fmt.Errorf("order not found for price index key %X", iter.Key()))
+		}
+		stop, err := cb(order)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateSellOrdersUnder iterates through matchable sell orders within
+// the pair whose price is less than or equal to the given price, from
+// the lowest price, and call cb on each order.
+func (k Keeper) IterateSellOrdersUnder(ctx sdk.Context, pairId uint64, price math.LegacyDec, cb func(order types.Order) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.GetOrdersByPriceIndexKeyPrefix(pairId, types.OrderDirectionSell),
+		sdk.PrefixEndBytes(types.GetOrdersByPriceIndexKeyPrefixWithPrice(pairId, types.OrderDirectionSell, price)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, _, _, orderId := types.ParseOrdersByPriceIndexKey(iter.Key())
+		order, found := k.GetOrder(ctx, pairId, orderId)
+		if !found { // the index is always written and deleted along with the order
+			panic(fmt.Errorf("order not found for price index key %X", iter.Key()))
+		}
+		stop, err := cb(order)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
 // GetAllOrders returns all orders in the store.
 func (k Keeper) GetAllOrders(ctx sdk.Context) (orders []types.Order) {
 	orders = []types.Order{}
@@ -520,11 +646,28 @@ func (k Keeper) GetOrdersByOrderer(ctx sdk.Context, orderer sdk.AccAddress) (ord
 	return
 }
 
+// GetExpiredOrders returns orders which have been expired at the given time.
+func (k Keeper) GetExpiredOrders(ctx sdk.Context, t time.Time) (orders []types.Order) {
+	_ = k.IterateExpiredOrders(ctx, t, func(order types.Order) (stop bool, err error) {
+		orders = append(orders, order)
+		return false, nil
+	})
+	return
+}
+
 // DeleteOrder deletes an order.
 func (k Keeper) DeleteOrder(ctx sdk.Context, order types.Order) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetOrderKey(order.PairId, order.Id))
 	k.DeleteOrderIndex(ctx, order)
+	k.DeleteOrderExpiryIndex(ctx, order)
+	k.DeleteOrdersByPriceIndex(ctx, order)
+	for _, field := range types.OrderIndexFields {
+		k.DeleteOrderFieldIndex(ctx, order, field)
+	}
+	if order.Type == types.OrderTypeMM {
+		k.removeOrderFromMMOrderIndex(ctx, order)
+	}
 }
 
 func (k Keeper) DeleteOrderIndex(ctx sdk.Context, order types.Order) {
@@ -532,6 +675,27 @@ func (k Keeper) DeleteOrderIndex(ctx sdk.Context, order types.Order) {
 	store.Delete(types.GetOrderIndexKey(order.GetOrderer(), order.PairId, order.Id))
 }
 
+// DeleteOrderExpiryIndex deletes an order expiry index.
+func (k Keeper) DeleteOrderExpiryIndex(ctx sdk.Context, order types.Order) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOrderExpiryIndexKey(order.ExpireAt, order.PairId, order.Id))
+}
+
+// DeleteOrdersByPriceIndex deletes an orders by price index.
+func (k Keeper) DeleteOrdersByPriceIndex(ctx sdk.Context, order types.Order) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOrdersByPriceIndexKey(order.PairId, order.Direction, order.Price, order.BatchId, order.Id))
+}
+
+// DeleteOrderFieldIndex deletes the indexes of an order by the value of the
+// field.
+func (k Keeper) DeleteOrderFieldIndex(ctx sdk.Context, order types.Order, field types.OrderIndexField) {
+	store := ctx.KVStore(k.storeKey)
+	value := types.OrderIndexFieldValue(order, field)
+	store.Delete(types.GetOrdersByFieldIndexKey(order.PairId, field, value, order.Id))
+	store.Delete(types.GetOrdersByOrdererFieldIndexKey(order.GetOrderer(), field, value, order.PairId, order.Id))
+}
+
 // GetMMOrderIndex returns the market making order index.
 func (k Keeper) GetMMOrderIndex(ctx sdk.Context, orderer sdk.AccAddress, pairId uint64) (index types.MMOrderIndex, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -585,3 +749,117 @@ func (k Keeper) DeleteMMOrderIndex(ctx sdk.Context, index types.MMOrderIndex) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetMMOrderIndexKey(index.GetOrderer(), index.PairId))
 }
+
+// removeOrderFromMMOrderIndex removes the order's id from the orderer's
+// market making order index, so the index never refers to deleted orders.
+// The index itself is deleted when it becomes empty.
+func (k Keeper) removeOrderFromMMOrderIndex(ctx sdk.Context, order types.Order) {
+	index, found := k.GetMMOrderIndex(ctx, order.GetOrderer(), order.PairId)
+	if !found {
+		return
+	}
+	orderIds := make([]uint64, 0, len(index.OrderIds))
+	for _, orderId := range index.OrderIds {
+		if orderId != order.Id {
+			orderIds = append(orderIds, orderId)
+		}
+	}
+	switch {
+	case len(orderIds) == len(index.OrderIds):
+		return
+	case len(orderIds) == 0:
+		k.DeleteMMOrderIndex(ctx, index)
+	default:
+		index.OrderIds = orderIds
+		k.SetMMOrderIndex(ctx, index)
+	}
+}
+
+// SetDepositRequestDeletionQueue queues a deposit request to be deleted at
+// the given height.
+func (k Keeper) SetDepositRequestDeletionQueue(ctx sdk.Context, height int64, req types.DepositRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDepositRequestDeletionQueueKey(height, req.PoolId, req.Id), []byte{})
+}
+
+// SetWithdrawRequestDeletionQueue queues a withdraw request to be deleted at
+// the given height.
+func (k Keeper) SetWithdrawRequestDeletionQueue(ctx sdk.Context, height int64, req types.WithdrawRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetWithdrawRequestDeletionQueueKey(height, req.PoolId, req.Id), []byte{})
+}
+
+// SetOrderDeletionQueue queues an order to be deleted at the given height.
+func (k Keeper) SetOrderDeletionQueue(ctx sdk.Context, height int64, order types.Order) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOrderDeletionQueueKey(height, order.PairId, order.Id), []byte{})
+}
+
+// GetICARequest returns the ICA request of the channel and packet sequence.
+func (k Keeper) GetICARequest(ctx sdk.Context, channelId string, sequence uint64) (req types.ICARequest, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetICARequestKey(channelId, sequence))
+	if bz == nil {
+		return
+	}
+	req = types.MustUnmarshalICARequest(k.cdc, bz)
+	return req, true
+}
+
+// SetICARequest stores an ICA request.
+func (k Keeper) SetICARequest(ctx sdk.Context, req types.ICARequest) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalICARequest(k.cdc, req)
+	store.Set(types.GetICARequestKey(req.ChannelId, req.Sequence), bz)
+}
+
+// IterateAllICARequests iterates through all ICA requests in the store and
+// call cb for each request.
+func (k Keeper) IterateAllICARequests(ctx sdk.Context, cb func(req types.ICARequest) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ICARequestKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		req := types.MustUnmarshalICARequest(k.cdc, iter.Value())
+		stop, err := cb(req)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllICARequests returns all ICA requests in the store.
+func (k Keeper) GetAllICARequests(ctx sdk.Context) (reqs []types.ICARequest) {
+	reqs = []types.ICARequest{}
+	_ = k.IterateAllICARequests(ctx, func(req types.ICARequest) (stop bool, err error) {
+		reqs = append(reqs, req)
+		return false, nil
+	})
+	return
+}
+
+// IterateDeletionQueueUntil iterates through the deletion queue of the given
+// prefix up to the given height, inclusively, and call cb with each queue
+// key.
+// prefix must be one of DepositRequestDeletionQueueKeyPrefix,
+// WithdrawRequestDeletionQueueKeyPrefix or OrderDeletionQueueKeyPrefix.
+func (k Keeper) IterateDeletionQueueUntil(ctx sdk.Context, prefix []byte, height int64, cb func(key []byte) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	endKey := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+	iter := store.Iterator(prefix, sdk.PrefixEndBytes(endKey))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		stop, err := cb(iter.Key())
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
@@ -2,13 +2,16 @@ package keeper
 
 import (
 	"fmt"
+	"runtime"
+	"sort"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 
 	"shogun/x/liquidity/amm"
 	"shogun/x/liquidity/types"
@@ -109,22 +112,39 @@ func (k Keeper) LimitOrder(ctx sdk.Context, msg *types.MsgLimitOrder) (types.Ord
 
 	ctx.GasMeter().ConsumeGas(k.GetOrderExtraGas(ctx), "OrderExtraGas")
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeLimitOrder,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
-			sdk.NewAttribute(types.AttributeKeyOrderDirection, msg.Direction.String()),
-			sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
-			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
-			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(order.BatchId, 10)),
-			sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
-			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
-		),
-	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventLimitOrder{
+		Orderer:         msg.Orderer,
+		PairId:          msg.PairId,
+		OrderId:         order.Id,
+		BatchId:         order.BatchId,
+		Direction:       msg.Direction,
+		OfferCoin:       offerCoin,
+		DemandCoinDenom: msg.DemandCoinDenom,
+		Price:           price,
+		Amount:          msg.Amount,
+		ExpireAt:        order.ExpireAt,
+		RefundedCoins:   sdk.NewCoins(refundedCoin),
+	}); err != nil {
+		return types.Order{}, err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeLimitOrder,
+				sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+				sdk.NewAttribute(types.AttributeKeyOrderDirection, msg.Direction.String()),
+				sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
+				sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(order.BatchId, 10)),
+				sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
+				sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
+			),
+		})
+	}
 
 	return order, nil
 }
@@ -212,22 +232,39 @@ func (k Keeper) MarketOrder(ctx sdk.Context, msg *types.MsgMarketOrder) (types.O
 
 	ctx.GasMeter().ConsumeGas(k.GetOrderExtraGas(ctx), "OrderExtraGas")
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeMarketOrder,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
-			sdk.NewAttribute(types.AttributeKeyOrderDirection, msg.Direction.String()),
-			sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
-			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
-			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(order.BatchId, 10)),
-			sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
-			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
-		),
-	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketOrder{
+		Orderer:         msg.Orderer,
+		PairId:          msg.PairId,
+		OrderId:         order.Id,
+		BatchId:         order.BatchId,
+		Direction:       msg.Direction,
+		OfferCoin:       offerCoin,
+		DemandCoinDenom: msg.DemandCoinDenom,
+		Price:           price,
+		Amount:          msg.Amount,
+		ExpireAt:        order.ExpireAt,
+		RefundedCoins:   sdk.NewCoins(refundedCoin),
+	}); err != nil {
+		return types.Order{}, err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeMarketOrder,
+				sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+				sdk.NewAttribute(types.AttributeKeyOrderDirection, msg.Direction.String()),
+				sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
+				sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(order.BatchId, 10)),
+				sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
+				sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
+			),
+		})
+	}
 
 	return order, nil
 }
@@ -363,16 +400,27 @@ func (k Keeper) MMOrder(ctx sdk.Context, msg *types.MsgMMOrder) (orders []types.
 
 	k.SetMMOrderIndex(ctx, types.NewMMOrderIndex(orderer, pair.Id, orderIds))
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeMMOrder,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
-			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(pair.CurrentBatchId, 10)),
-			sdk.NewAttribute(types.AttributeKeyOrderIds, types.FormatUint64s(orderIds)),
-			sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
-		),
-	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMMOrder{
+		Orderer:          msg.Orderer,
+		PairId:           msg.PairId,
+		BatchId:          pair.CurrentBatchId,
+		OrderIds:         orderIds,
+		CanceledOrderIds: canceledOrderIds,
+	}); err != nil {
+		return nil, err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeMMOrder,
+				sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+				sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(pair.CurrentBatchId, 10)),
+				sdk.NewAttribute(types.AttributeKeyOrderIds, types.FormatUint64s(orderIds)),
+				sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
+			),
+		})
+	}
 	return
 }
 
@@ -408,14 +456,23 @@ func (k Keeper) CancelOrder(ctx sdk.Context, msg *types.MsgCancelOrder) error {
 		return err
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCancelOrder,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
-			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(msg.OrderId, 10)),
-		),
-	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCancelOrder{
+		Orderer: msg.Orderer,
+		PairId:  msg.PairId,
+		OrderId: msg.OrderId,
+	}); err != nil {
+		return err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCancelOrder,
+				sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+				sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(msg.OrderId, 10)),
+			),
+		})
+	}
 
 	return nil
 }
@@ -424,18 +481,18 @@ func (k Keeper) CancelOrder(ctx sdk.Context, msg *types.MsgCancelOrder) error {
 func (k Keeper) CancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders) error {
 	orderPairCache := map[uint64]types.Pair{} // maps order's pair id to pair, to cache the result
 	pairIdSet := map[uint64]struct{}{}        // set of pairs where to cancel orders
-	var pairIds []string                      // needed to emit an event
+	var pairIds []uint64                      // needed to emit an event
 	for _, pairId := range msg.PairIds {
 		pair, found := k.GetPair(ctx, pairId)
 		if !found { // check if the pair exists
 			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
 		}
 		pairIdSet[pairId] = struct{}{} // add pair id to the set
-		pairIds = append(pairIds, strconv.FormatUint(pairId, 10))
+		pairIds = append(pairIds, pairId)
 		orderPairCache[pairId] = pair // also cache the pair to use at below
 	}
 
-	var canceledOrderIds []string
+	var canceledOrderIds []uint64
 	if err := k.IterateOrdersByOrderer(ctx, msg.GetOrderer(), func(order types.Order) (stop bool, err error) {
 		_, ok := pairIdSet[order.PairId] // is the pair included in the pair set?
 		if len(pairIdSet) == 0 || ok {   // pair ids not specified(cancel all), or the pair is in the set
@@ -448,7 +505,7 @@ func (k Keeper) CancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders)
 				if err := k.FinishOrder(ctx, order, types.OrderStatusCanceled); err != nil {
 					return false, err
 				}
-				canceledOrderIds = append(canceledOrderIds, strconv.FormatUint(order.Id, 10))
+				canceledOrderIds = append(canceledOrderIds, order.Id)
 			}
 		}
 		return false, nil
@@ -456,14 +513,23 @@ func (k Keeper) CancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders)
 		return err
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCancelAllOrders,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairIds, strings.Join(pairIds, ",")),
-			sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, strings.Join(canceledOrderIds, ",")),
-		),
-	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCancelAllOrders{
+		Orderer:          msg.Orderer,
+		PairIds:          pairIds,
+		CanceledOrderIds: canceledOrderIds,
+	}); err != nil {
+		return err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCancelAllOrders,
+				sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+				sdk.NewAttribute(types.AttributeKeyPairIds, types.FormatUint64s(pairIds)),
+				sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
+			),
+		})
+	}
 
 	return nil
 }
@@ -507,48 +573,168 @@ func (k Keeper) CancelMMOrder(ctx sdk.Context, msg *types.MsgCancelMMOrder) (can
 		return
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCancelMMOrder,
-			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
-		),
-	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCancelMMOrder{
+		Orderer:          msg.Orderer,
+		PairId:           pair.Id,
+		CanceledOrderIds: canceledOrderIds,
+	}); err != nil {
+		return nil, err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCancelMMOrder,
+				sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
+			),
+		})
+	}
 
 	return canceledOrderIds, nil
 }
 
+// ExecuteMatching executes matching on the pair.
 func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
-	ob := amm.NewOrderBook()
+	m, err := k.preparePairMatching(ctx, pair)
+	if err != nil {
+		return err
+	}
+	m.run()
+	return k.applyPairMatching(ctx, m)
+}
 
-	if err := k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
+// ExecuteMatchingOnPairs executes matching on multiple pairs.
+// Since pairs don't share any state involved in matching, the matching
+// itself runs concurrently on a bounded number of workers, against a
+// snapshot of the state read beforehand.
+// Then the results are applied serially, in the order of the pair id, which
+// keeps the state transition deterministic.
+func (k Keeper) ExecuteMatchingOnPairs(ctx sdk.Context, pairs []types.Pair) error {
+	ms := make([]*pairMatching, len(pairs))
+	for i, pair := range pairs {
+		m, err := k.preparePairMatching(ctx, pair)
+		if err != nil {
+			return err
+		}
+		ms[i] = m
+	}
+	sort.Slice(ms, func(i, j int) bool {
+		return ms[i].pair.Id < ms[j].pair.Id
+	})
+
+	// Pairs with nothing to match don't need a worker.
+	var jobs []*pairMatching
+	for _, m := range ms {
+		if len(m.orders) == 0 && len(m.pools) == 0 {
+			m.skip()
+			continue
+		}
+		jobs = append(jobs, m)
+	}
+	runPairMatchings(jobs, runtime.GOMAXPROCS(0))
+
+	for _, m := range ms {
+		if m.recovered != nil {
+			panic(m.recovered)
+		}
+		if err := k.applyPairMatching(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runPairMatchings runs matching on ms using at most numWorkers goroutines.
+func runPairMatchings(ms []*pairMatching, numWorkers int) {
+	if numWorkers > len(ms) {
+		numWorkers = len(ms)
+	}
+	ch := make(chan *pairMatching)
+	var wg sync.WaitGroup
+	for i := 0; i < numWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for m := range ch {
+				m.safeRun()
+			}
+		}()
+	}
+	for _, m := range ms {
+		ch <- m
+	}
+	close(ch)
+	wg.Wait()
+}
+
+// pairMatching holds the state of a pair needed to run matching, which is
+// read from the store beforehand so that running matching doesn't touch the
+// store at all.
+type pairMatching struct {
+	pair            types.Pair
+	tickPrec        int
+	priceLimitRatio math.LegacyDec
+	orders          []types.Order // orders to be matched
+	expiredOrders   []types.Order
+	pools           []*types.PoolOrderer
+	depletedPools   []types.Pool
+
+	// Below are set by run.
+	ob            *amm.OrderBook
+	matchPrice    math.LegacyDec
+	quoteCoinDiff math.Int
+	priceDir      amm.PriceDirection
+	matched       bool
+	recovered     interface{}
+}
+
+// preparePairMatching reads the state needed to run matching on the pair.
+// preparePairMatching doesn't write anything to the store.
+func (k Keeper) preparePairMatching(ctx sdk.Context, pair types.Pair) (*pairMatching, error) {
+	m := &pairMatching{
+		pair:            pair,
+		tickPrec:        int(k.GetTickPrecision(ctx)),
+		priceLimitRatio: k.GetMaxPriceLimitRatio(ctx),
+	}
+
+	collect := func(order types.Order) (stop bool, err error) {
 		switch order.Status {
 		case types.OrderStatusNotExecuted,
 			types.OrderStatusNotMatched,
 			types.OrderStatusPartiallyMatched:
 			if order.Status != types.OrderStatusNotExecuted && order.ExpiredAt(ctx.BlockTime()) {
-				if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
-					return false, err
-				}
+				m.expiredOrders = append(m.expiredOrders, order)
 				return false, nil
 			}
-			// TODO: add orders only when price is in the range?
-			ob.AddOrder(types.NewUserOrder(order))
-			if order.Status == types.OrderStatusNotExecuted {
-				order.SetStatus(types.OrderStatusNotMatched)
-				k.SetOrder(ctx, order)
-			}
-		case types.OrderStatusCanceled:
+			m.orders = append(m.orders, order)
+		case types.OrderStatusCompleted, types.OrderStatusCanceled, types.OrderStatusExpired:
+			// Finished orders may stay in the store until they're deleted.
 		default:
 			return false, fmt.Errorf("invalid order status: %s", order.Status)
 		}
 		return false, nil
-	}); err != nil {
-		return err
+	}
+	if pair.LastPrice == nil {
+		// Without the last price, the match price can be anywhere so
+		// all orders should be considered.
+		if err := k.IterateOrdersByPair(ctx, pair.Id, collect); err != nil {
+			return nil, err
+		}
+	} else {
+		// Only orders that can be matched within the price limits are
+		// considered, which are buy orders with price higher than or equal
+		// to the lowest price and sell orders with price lower than or
+		// equal to the highest price.
+		lowestPrice, highestPrice := types.PriceLimits(*pair.LastPrice, m.priceLimitRatio, m.tickPrec)
+		if err := k.IterateBuyOrdersOver(ctx, pair.Id, lowestPrice, collect); err != nil {
+			return nil, err
+		}
+		if err := k.IterateSellOrdersUnder(ctx, pair.Id, highestPrice, collect); err != nil {
+			return nil, err
+		}
 	}
 
-	var pools []*types.PoolOrderer
 	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
 		if pool.Disabled {
 			return false, nil
@@ -559,30 +745,139 @@ func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
 			pool.AMMPool(rx.Amount, ry.Amount, ps),
 			pool.Id, pool.GetReserveAddress(), pair.BaseCoinDenom, pair.QuoteCoinDenom)
 		if ammPool.IsDepleted() {
-			k.MarkPoolAsDisabled(ctx, pool)
+			m.depletedPools = append(m.depletedPools, pool)
 			return false, nil
 		}
-		pools = append(pools, ammPool)
+		m.pools = append(m.pools, ammPool)
 		return false, nil
 	})
 
-	matchPrice, quoteCoinDiff, matched := k.Match(ctx, ob, pools, pair.LastPrice)
-	if matched {
-		orders := ob.Orders()
-		if err := k.ApplyMatchResult(ctx, pair, orders, quoteCoinDiff); err != nil {
+	return m, nil
+}
+
+// run runs matching on the pair.
+// It is safe to call run concurrently on different pairMatching objects.
+func (m *pairMatching) run() {
+	m.ob = amm.NewOrderBook()
+	for _, order := range m.orders {
+		m.ob.AddOrder(types.NewUserOrder(order))
+	}
+	m.matchPrice, m.quoteCoinDiff, m.priceDir, m.matched = MatchOrderBook(m.ob, m.pools, m.pair.LastPrice, m.tickPrec, m.priceLimitRatio)
+}
+
+// safeRun calls run, recovering a panic to be re-panicked later in the
+// caller's goroutine, since a panic in other goroutines can't be recovered
+// by the caller.
+func (m *pairMatching) safeRun() {
+	defer func() {
+		m.recovered = recover()
+	}()
+	m.run()
+}
+
+// skip sets the result of matching on a pair with neither orders nor pools,
+// which is the same as what run would set but without building anything.
+func (m *pairMatching) skip() {
+	m.ob = amm.NewOrderBook()
+	m.priceDir = amm.PriceStaying
+}
+
+// applyPairMatching applies the matching result to the store.
+func (k Keeper) applyPairMatching(ctx sdk.Context, m *pairMatching) error {
+	pair := m.pair
+
+	for _, order := range m.expiredOrders {
+		if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
 			return err
 		}
+	}
+	for _, order := range m.orders {
+		if order.Status == types.OrderStatusNotExecuted {
+			order.SetStatus(types.OrderStatusNotMatched)
+			k.SetOrder(ctx, order)
+		}
+	}
+	for _, pool := range m.depletedPools {
+		k.MarkPoolAsDisabled(ctx, pool)
+	}
+
+	event := &types.EventBatchExecuted{
+		PairId:             pair.Id,
+		BatchId:            pair.CurrentBatchId,
+		Matched:            m.matched,
+		MatchedBaseAmount:  sdk.ZeroInt(),
+		MatchedQuoteAmount: sdk.ZeroInt(),
+		Dust:               sdk.NewCoin(pair.QuoteCoinDenom, sdk.ZeroInt()),
+	}
+	if pair.LastPrice != nil {
+		event.PriceDirection = types.PriceDirectionFromAMM(m.priceDir)
+	}
+
+	if m.matched {
+		orders := m.ob.Orders()
+		if err := k.ApplyMatchResult(ctx, pair, orders, m.quoteCoinDiff); err != nil {
+			return err
+		}
+		matchPrice := m.matchPrice
 		pair.LastPrice = &matchPrice
+
+		event.MatchPrice = &matchPrice
+		event.Dust.Amount = m.quoteCoinDiff
+		for _, order := range orders {
+			if !order.IsMatched() {
+				continue
+			}
+			// Buy orders receive base coin and sell orders receive quote coin.
+			switch order.GetDirection() {
+			case amm.Buy:
+				event.MatchedBaseAmount = event.MatchedBaseAmount.Add(order.GetReceivedDemandCoinAmount())
+			case amm.Sell:
+				event.MatchedQuoteAmount = event.MatchedQuoteAmount.Add(order.GetReceivedDemandCoinAmount())
+			}
+			if _, ok := order.(*types.UserOrder); ok {
+				event.NumFilledOrders++
+			}
+		}
 	}
 
 	pair.CurrentBatchId++
 	k.SetPair(ctx, pair)
 
-	return nil
+	event.LastPrice = pair.LastPrice
+	if k.emitLegacyEvents {
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(event.BatchId, 10)),
+			sdk.NewAttribute(types.AttributeKeyMatched, strconv.FormatBool(event.Matched)),
+			sdk.NewAttribute(types.AttributeKeyPriceDirection, event.PriceDirection.String()),
+			sdk.NewAttribute(types.AttributeKeyMatchedBaseAmount, event.MatchedBaseAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyMatchedQuoteAmount, event.MatchedQuoteAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyNumFilledOrders, strconv.FormatUint(event.NumFilledOrders, 10)),
+			sdk.NewAttribute(types.AttributeKeyDust, event.Dust.String()),
+		}
+		if event.MatchPrice != nil {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyMatchPrice, event.MatchPrice.String()))
+		}
+		if event.LastPrice != nil {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyLastPrice, event.LastPrice.String()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeBatchExecuted, attrs...))
+	}
+	return ctx.EventManager().EmitTypedEvent(event)
 }
 
 func (k Keeper) Match(ctx sdk.Context, ob *amm.OrderBook, pools []*types.PoolOrderer, lastPrice *math.LegacyDec) (matchPrice math.LegacyDec, quoteCoinDiff math.Int, matched bool) {
-	tickPrec := int(k.GetTickPrecision(ctx))
+	matchPrice, quoteCoinDiff, _, matched = MatchOrderBook(ob, pools, lastPrice, int(k.GetTickPrecision(ctx)), k.GetMaxPriceLimitRatio(ctx))
+	return
+}
+
+// MatchOrderBook matches orders in the order book along with the pools'
+// orders, which are added to the order book.
+// priceDir is the estimated price direction within the batch, which is
+// returned only if lastPrice is not nil.
+// MatchOrderBook doesn't access the store, so it can be used to reproduce
+// matching outside of a block.
+func MatchOrderBook(ob *amm.OrderBook, pools []*types.PoolOrderer, lastPrice *math.LegacyDec, tickPrec int, priceLimitRatio math.LegacyDec) (matchPrice math.LegacyDec, quoteCoinDiff math.Int, priceDir amm.PriceDirection, matched bool) {
 	if lastPrice == nil {
 		ov := amm.MultipleOrderViews{ob.MakeView()}
 		for _, pool := range pools {
@@ -591,7 +886,7 @@ func (k Keeper) Match(ctx sdk.Context, ob *amm.OrderBook, pools []*types.PoolOrd
 		var found bool
 		matchPrice, found = amm.FindMatchPrice(ov, tickPrec)
 		if !found {
-			return math.LegacyDec{}, math.Int{}, false
+			return math.LegacyDec{}, math.Int{}, 0, false
 		}
 		for _, pool := range pools {
 			buyAmt := pool.BuyAmountOver(matchPrice, true)
@@ -605,11 +900,12 @@ func (k Keeper) Match(ctx sdk.Context, ob *amm.OrderBook, pools []*types.PoolOrd
 		}
 		quoteCoinDiff, matched = ob.MatchAtSinglePrice(matchPrice)
 	} else {
-		lowestPrice, highestPrice := k.PriceLimits(ctx, *lastPrice)
+		lowestPrice, highestPrice := types.PriceLimits(*lastPrice, priceLimitRatio, tickPrec)
 		for _, pool := range pools {
 			poolOrders := amm.PoolOrders(pool, pool, lowestPrice, highestPrice, tickPrec)
 			ob.AddOrder(poolOrders...)
 		}
+		priceDir = ob.PriceDirection(*lastPrice)
 		matchPrice, quoteCoinDiff, matched = ob.Match(*lastPrice)
 	}
 	return
@@ -662,24 +958,48 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 				if err := k.FinishOrder(ctx, o, types.OrderStatusCompleted); err != nil {
 					return err
 				}
+			} else if types.IsTooSmallOrderAmount(o.OpenAmount, o.Price) {
+				// The remaining amount is too small to be matched again,
+				// so the order expires right away.
+				// TODO: should we introduce new order status for this type of expiration?
+				if err := k.FinishOrder(ctx, o, types.OrderStatusExpired); err != nil {
+					return err
+				}
 			} else {
 				o.SetStatus(types.OrderStatusPartiallyMatched)
 				k.SetOrder(ctx, o)
 			}
-			bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.Orderer, sdk.NewCoins(receivedCoin))
+			// The received coin of an order with a forward destination is
+			// kept in the escrow until the order is finished.
+			if o.Forward == nil {
+				bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.Orderer, sdk.NewCoins(receivedCoin))
+			}
 
-			ctx.EventManager().EmitEvents(sdk.Events{
-				sdk.NewEvent(
-					types.EventTypeUserOrderMatched,
-					sdk.NewAttribute(types.AttributeKeyOrderDirection, types.OrderDirectionFromAMM(order.Direction).String()),
-					sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer.String()),
-					sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
-					sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.OrderId, 10)),
-					sdk.NewAttribute(types.AttributeKeyMatchedAmount, matchedAmt.String()),
-					sdk.NewAttribute(types.AttributeKeyPaidCoin, paidCoin.String()),
-					sdk.NewAttribute(types.AttributeKeyReceivedCoin, receivedCoin.String()),
-				),
-			})
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventUserOrderMatched{
+				Orderer:       order.Orderer.String(),
+				PairId:        pair.Id,
+				OrderId:       order.OrderId,
+				Direction:     types.OrderDirectionFromAMM(order.Direction),
+				MatchedAmount: matchedAmt,
+				PaidCoin:      paidCoin,
+				ReceivedCoin:  receivedCoin,
+			}); err != nil {
+				return err
+			}
+			if k.emitLegacyEvents {
+				ctx.EventManager().EmitEvents(sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeUserOrderMatched,
+						sdk.NewAttribute(types.AttributeKeyOrderDirection, types.OrderDirectionFromAMM(order.Direction).String()),
+						sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer.String()),
+						sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+						sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.OrderId, 10)),
+						sdk.NewAttribute(types.AttributeKeyMatchedAmount, matchedAmt.String()),
+						sdk.NewAttribute(types.AttributeKeyPaidCoin, paidCoin.String()),
+						sdk.NewAttribute(types.AttributeKeyReceivedCoin, receivedCoin.String()),
+					),
+				})
+			}
 		case *types.PoolOrder:
 			paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
 			receivedCoin := sdk.NewCoin(order.DemandCoinDenom, order.ReceivedDemandCoinAmount)
@@ -714,17 +1034,29 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 		return err
 	}
 	for _, r := range poolMatchResults {
-		ctx.EventManager().EmitEvents(sdk.Events{
-			sdk.NewEvent(
-				types.EventTypePoolOrderMatched,
-				sdk.NewAttribute(types.AttributeKeyOrderDirection, r.OrderDirection.String()),
-				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
-				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(r.PoolId, 10)),
-				sdk.NewAttribute(types.AttributeKeyMatchedAmount, r.MatchedAmount.String()),
-				sdk.NewAttribute(types.AttributeKeyPaidCoin, r.PaidCoin.String()),
-				sdk.NewAttribute(types.AttributeKeyReceivedCoin, r.ReceivedCoin.String()),
-			),
-		})
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventPoolOrderMatched{
+			PairId:        pair.Id,
+			PoolId:        r.PoolId,
+			Direction:     r.OrderDirection,
+			MatchedAmount: r.MatchedAmount,
+			PaidCoin:      r.PaidCoin,
+			ReceivedCoin:  r.ReceivedCoin,
+		}); err != nil {
+			return err
+		}
+		if k.emitLegacyEvents {
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypePoolOrderMatched,
+					sdk.NewAttribute(types.AttributeKeyOrderDirection, r.OrderDirection.String()),
+					sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(r.PoolId, 10)),
+					sdk.NewAttribute(types.AttributeKeyMatchedAmount, r.MatchedAmount.String()),
+					sdk.NewAttribute(types.AttributeKeyPaidCoin, r.PaidCoin.String()),
+					sdk.NewAttribute(types.AttributeKeyReceivedCoin, r.ReceivedCoin.String()),
+				),
+			})
+		}
 	}
 	return nil
 }
@@ -734,31 +1066,97 @@ func (k Keeper) FinishOrder(ctx sdk.Context, order types.Order, status types.Ord
 		return nil
 	}
 
+	pair, _ := k.GetPair(ctx, order.PairId)
 	if order.RemainingOfferCoin.IsPositive() {
-		pair, _ := k.GetPair(ctx, order.PairId)
 		if err := k.bankKeeper.SendCoins(ctx, pair.GetEscrowAddress(), order.GetOrderer(), sdk.NewCoins(order.RemainingOfferCoin)); err != nil {
 			return err
 		}
 	}
+	if order.Forward != nil && order.ReceivedCoin.IsPositive() {
+		if err := k.forwardReceivedCoin(ctx, pair, order); err != nil {
+			return err
+		}
+	}
 
 	order.SetStatus(status)
 	k.SetOrder(ctx, order)
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeOrderResult,
-			sdk.NewAttribute(types.AttributeKeyOrderDirection, order.Direction.String()),
-			sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer),
-			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(order.PairId, 10)),
-			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyAmount, order.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyOpenAmount, order.OpenAmount.String()),
-			sdk.NewAttribute(types.AttributeKeyOfferCoin, order.OfferCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyRemainingOfferCoin, order.RemainingOfferCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyReceivedCoin, order.ReceivedCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyStatus, order.Status.String()),
-		),
-	})
+	k.SetOrderDeletionQueue(ctx, k.deletionHeight(ctx), order)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderResult{
+		Orderer:            order.Orderer,
+		PairId:             order.PairId,
+		OrderId:            order.Id,
+		Direction:          order.Direction,
+		Amount:             order.Amount,
+		OpenAmount:         order.OpenAmount,
+		OfferCoin:          order.OfferCoin,
+		RemainingOfferCoin: order.RemainingOfferCoin,
+		ReceivedCoin:       order.ReceivedCoin,
+		Status:             order.Status,
+	}); err != nil {
+		return err
+	}
+	if k.emitLegacyEvents {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeOrderResult,
+				sdk.NewAttribute(types.AttributeKeyOrderDirection, order.Direction.String()),
+				sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(order.PairId, 10)),
+				sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyAmount, order.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyOpenAmount, order.OpenAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyOfferCoin, order.OfferCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyRemainingOfferCoin, order.RemainingOfferCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyReceivedCoin, order.ReceivedCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyStatus, order.Status.String()),
+			),
+		})
+	}
 
 	return nil
 }
+
+// forwardReceivedCoin sends the coin received by the order, which has been
+// kept in the escrow, to the forward destination of the order over IBC.
+// The coin is sent to the orderer first and then transferred from the
+// orderer, so the transfer module refunds it to the orderer when the transfer
+// fails or times out.
+// If the transfer couldn't be initiated, the orderer keeps the coin.
+func (k Keeper) forwardReceivedCoin(ctx sdk.Context, pair types.Pair, order types.Order) error {
+	if err := k.bankKeeper.SendCoins(ctx, pair.GetEscrowAddress(), order.GetOrderer(), sdk.NewCoins(order.ReceivedCoin)); err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(cacheCtx), &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    order.Forward.ChannelId,
+		Token:            order.ReceivedCoin,
+		Sender:           order.Orderer,
+		Receiver:         order.Forward.Receiver,
+		TimeoutTimestamp: uint64(ctx.BlockTime().Add(order.Forward.Timeout).UnixNano()),
+	})
+	if err != nil {
+		k.Logger(ctx).Info(
+			"failed to forward received coin", "pair_id", order.PairId, "order_id", order.Id, "error", err)
+		return ctx.EventManager().EmitTypedEvent(&types.EventIBCForwardFailed{
+			Orderer: order.Orderer,
+			PairId:  order.PairId,
+			OrderId: order.Id,
+			Coin:    order.ReceivedCoin,
+			Error:   err.Error(),
+		})
+	}
+	writeCache()
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIBCForward{
+		Orderer:   order.Orderer,
+		PairId:    order.PairId,
+		OrderId:   order.Id,
+		Coin:      order.ReceivedCoin,
+		ChannelId: order.Forward.ChannelId,
+		Receiver:  order.Forward.Receiver,
+		Sequence:  res.Sequence,
+	})
+}
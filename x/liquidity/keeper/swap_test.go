@@ -743,26 +743,6 @@ import (
 // 	s.Require().ErrorIs(err, types.ErrTooSmallOrder)
 // }
 
-// func (s *KeeperTestSuite) TestExpireSmallOrders() {
-// 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
-
-// 	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.000018"), math.NewInt(10000000), time.Minute, true)
-// 	// This order should have 10000 open amount after matching.
-// 	// If this order would be matched after that, then the orderer will receive
-// 	// floor(10000*0.000018) demand coin, which is zero.
-// 	// So the order must have been expired after matching.
-// 	order := s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.000018"), math.NewInt(10010000), time.Minute, true)
-// 	liquidity.EndBlocker(s.ctx, s.keeper)
-// 	order, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
-// 	s.Require().True(found)
-// 	s.Require().Equal(types.OrderStatusExpired, order.Status)
-// 	liquidity.BeginBlocker(s.ctx, s.keeper) // Delete outdated states.
-
-// 	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.000019"), math.NewInt(100000000), time.Minute, true)
-// 	s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("0.000019"), math.NewInt(100000000), time.Minute, true)
-// 	liquidity.EndBlocker(s.ctx, s.keeper)
-// }
-
 // func (s *KeeperTestSuite) TestPoolOrderOverflow() {
 // 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 // 	i, _ := math.NewIntFromString("10000000000000000000000000")
@@ -0,0 +1,199 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"shogun/x/liquidity/exported"
+	"shogun/x/liquidity/types"
+)
+
+// Key prefixes of the store layout before v4.
+var (
+	DepositRequestIndexKeyPrefix  = []byte{0xb4}
+	WithdrawRequestIndexKeyPrefix = []byte{0xb5}
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4.
+// The migration includes:
+//
+//   - Moving the module parameters from the legacy x/params subspace to the
+//     module store.
+//   - Rekeying deposit and withdraw request indexes with their new prefixes.
+//   - Backfilling the order expiry index, the orders by price index, the
+//     orders by field indexes and the deletion queues of finished orders and
+//     requests, which didn't exist in v3.
+//   - Removing ids of orders that no longer exist from market making order
+//     indexes, since v3 didn't remove them when deleting orders.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, legacySubspace exported.Subspace) error {
+	store := ctx.KVStore(storeKey)
+
+	params, err := migrateParams(ctx, store, cdc, legacySubspace)
+	if err != nil {
+		return err
+	}
+
+	migrateRequestIndexes(store)
+
+	// Same as the deletion height of records finished at the current block.
+	deletionHeight := ctx.BlockHeight() + 1 + int64(params.FinishedRecordRetentionBlocks)
+	if err := backfillRequestDeletionQueues(store, cdc, deletionHeight); err != nil {
+		return err
+	}
+	if err := backfillOrderIndexes(store, cdc, deletionHeight); err != nil {
+		return err
+	}
+	return pruneMMOrderIndexes(store, cdc)
+}
+
+// migrateParams moves the module parameters from the legacy subspace to the
+// module store.
+// Parameters missing in the legacy subspace, which are
+// FinishedRecordRetentionBlocks, FeeDenoms and MinFeePoolReserve in v3, are
+// set to their default values.
+func migrateParams(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, legacySubspace exported.Subspace) (types.Params, error) {
+	params := types.DefaultParams()
+	legacySubspace.GetParamSetIfExists(ctx, &params)
+	if err := params.Validate(); err != nil {
+		return types.Params{}, err
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+	return params, nil
+}
+
+// migrateRequestIndexes moves deposit and withdraw request indexes to their
+// new prefixes.
+// The rest of the index keys stays the same.
+func migrateRequestIndexes(store sdk.KVStore) {
+	for _, p := range []struct {
+		oldPrefix, newPrefix []byte
+	}{
+		{DepositRequestIndexKeyPrefix, types.DepositRequestIndexKeyPrefix},
+		{WithdrawRequestIndexKeyPrefix, types.WithdrawRequestIndexKeyPrefix},
+	} {
+		// Collect keys first, since the store must not be modified while
+		// iterating.
+		var keys [][]byte
+		iter := sdk.KVStorePrefixIterator(store, p.oldPrefix)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			newKey := append(append([]byte{}, p.newPrefix...), key[len(p.oldPrefix):]...)
+			store.Set(newKey, store.Get(key))
+			store.Delete(key)
+		}
+	}
+}
+
+// backfillRequestDeletionQueues queues finished deposit and withdraw
+// requests to be deleted at the given height.
+func backfillRequestDeletionQueues(store sdk.KVStore, cdc codec.BinaryCodec, deletionHeight int64) error {
+	iter := sdk.KVStorePrefixIterator(store, types.DepositRequestKeyPrefix)
+	defer iter.Close()
+	var queueKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		req, err := types.UnmarshalDepositRequest(cdc, iter.Value())
+		if err != nil {
+			return err
+		}
+		if req.Status.ShouldBeDeleted() {
+			queueKeys = append(queueKeys, types.GetDepositRequestDeletionQueueKey(deletionHeight, req.PoolId, req.Id))
+		}
+	}
+
+	iter2 := sdk.KVStorePrefixIterator(store, types.WithdrawRequestKeyPrefix)
+	defer iter2.Close()
+	for ; iter2.Valid(); iter2.Next() {
+		req, err := types.UnmarshalWithdrawRequest(cdc, iter2.Value())
+		if err != nil {
+			return err
+		}
+		if req.Status.ShouldBeDeleted() {
+			queueKeys = append(queueKeys, types.GetWithdrawRequestDeletionQueueKey(deletionHeight, req.PoolId, req.Id))
+		}
+	}
+
+	for _, key := range queueKeys {
+		store.Set(key, []byte{})
+	}
+	return nil
+}
+
+// backfillOrderIndexes sets the order expiry index, the orders by price
+// index and the orders by field indexes of orders, and queues finished orders
+// to be deleted at the given height.
+func backfillOrderIndexes(store sdk.KVStore, cdc codec.BinaryCodec, deletionHeight int64) error {
+	iter := sdk.KVStorePrefixIterator(store, types.OrderKeyPrefix)
+	defer iter.Close()
+	var indexKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		order, err := types.UnmarshalOrder(cdc, iter.Value())
+		if err != nil {
+			return err
+		}
+		if order.Status.CanBeExpired() {
+			indexKeys = append(indexKeys, types.GetOrderExpiryIndexKey(order.ExpireAt, order.PairId, order.Id))
+		}
+		if order.Status.IsMatchable() {
+			indexKeys = append(indexKeys, types.GetOrdersByPriceIndexKey(
+				order.PairId, order.Direction, order.Price, order.BatchId, order.Id))
+		}
+		if order.Status.ShouldBeDeleted() {
+			indexKeys = append(indexKeys, types.GetOrderDeletionQueueKey(deletionHeight, order.PairId, order.Id))
+		}
+		for _, field := range types.OrderIndexFields {
+			value := types.OrderIndexFieldValue(order, field)
+			indexKeys = append(indexKeys,
+				types.GetOrdersByFieldIndexKey(order.PairId, field, value, order.Id),
+				types.GetOrdersByOrdererFieldIndexKey(order.GetOrderer(), field, value, order.PairId, order.Id))
+		}
+	}
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+	return nil
+}
+
+// pruneMMOrderIndexes removes ids of orders that don't exist from market
+// making order indexes, and deletes indexes that become empty.
+func pruneMMOrderIndexes(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.MMOrderIndexKeyPrefix)
+	defer iter.Close()
+	var (
+		updated []types.MMOrderIndex
+		deleted [][]byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		var index types.MMOrderIndex
+		if err := cdc.Unmarshal(iter.Value(), &index); err != nil {
+			return err
+		}
+		orderIds := make([]uint64, 0, len(index.OrderIds))
+		for _, orderId := range index.OrderIds {
+			if store.Has(types.GetOrderKey(index.PairId, orderId)) {
+				orderIds = append(orderIds, orderId)
+			}
+		}
+		switch {
+		case len(orderIds) == len(index.OrderIds):
+		case len(orderIds) == 0:
+			deleted = append(deleted, iter.Key())
+		default:
+			index.OrderIds = orderIds
+			updated = append(updated, index)
+		}
+	}
+
+	for _, index := range updated {
+		store.Set(types.GetMMOrderIndexKey(index.GetOrderer(), index.PairId), cdc.MustMarshal(&index))
+	}
+	for _, key := range deleted {
+		store.Delete(key)
+	}
+	return nil
+}
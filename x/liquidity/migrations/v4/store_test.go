@@ -0,0 +1,208 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	chain "shogun/app"
+	utils "shogun/types"
+	"shogun/x/liquidity/keeper"
+	v4 "shogun/x/liquidity/migrations/v4"
+	"shogun/x/liquidity/types"
+)
+
+func setupMigrationTest(t *testing.T) (*chain.App, sdk.Context) {
+	t.Helper()
+	app := chain.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Height: 100,
+		Time:   utils.ParseTime("2022-01-01T00:00:00Z"),
+	})
+	// The v3 store didn't have params in it.
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+	return app, ctx
+}
+
+func migrate(t *testing.T, app *chain.App, ctx sdk.Context) {
+	t.Helper()
+	require.NoError(t, v4.MigrateStore(
+		ctx, app.GetKey(types.StoreKey), app.AppCodec(), app.GetSubspace(types.ModuleName)))
+}
+
+func TestMigrateStore_Params(t *testing.T) {
+	app, ctx := setupMigrationTest(t)
+	legacySubspace := app.GetSubspace(types.ModuleName)
+	legacySubspace.Set(ctx, types.KeyBatchSize, uint32(5))
+	legacySubspace.Set(ctx, types.KeyTickPrecision, uint32(2))
+	legacySubspace.Set(ctx, types.KeyMaxOrderLifespan, 12*time.Hour)
+
+	migrate(t, app, ctx)
+
+	params := app.LiquidityKeeper.GetParams(ctx)
+	require.EqualValues(t, 5, params.BatchSize)
+	require.EqualValues(t, 2, params.TickPrecision)
+	require.Equal(t, 12*time.Hour, params.MaxOrderLifespan)
+	// Params missing in the legacy subspace are set to their defaults.
+	defParams := types.DefaultParams()
+	require.Equal(t, defParams.FinishedRecordRetentionBlocks, params.FinishedRecordRetentionBlocks)
+	require.Equal(t, defParams.FeeDenoms, params.FeeDenoms)
+	require.True(t, defParams.MinFeePoolReserve.Equal(params.MinFeePoolReserve))
+	require.Equal(t, defParams.FeeCollectorAddress, params.FeeCollectorAddress)
+}
+
+func TestMigrateStore_InvalidParams(t *testing.T) {
+	app, ctx := setupMigrationTest(t)
+	app.GetSubspace(types.ModuleName).Set(ctx, types.KeyBatchSize, uint32(0))
+
+	err := v4.MigrateStore(
+		ctx, app.GetKey(types.StoreKey), app.AppCodec(), app.GetSubspace(types.ModuleName))
+	require.Error(t, err)
+}
+
+func TestMigrateStore_RequestIndexes(t *testing.T) {
+	app, ctx := setupMigrationTest(t)
+	cdc := app.AppCodec()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	depositor, withdrawer := utils.TestAddress(0), utils.TestAddress(1)
+
+	depositReq := types.DepositRequest{
+		Id:           1,
+		PoolId:       1,
+		MsgHeight:    90,
+		Depositor:    depositor.String(),
+		DepositCoins: utils.ParseCoins("1000000denom1,1000000denom2"),
+		Status:       types.RequestStatusSucceeded,
+	}
+	withdrawReq := types.WithdrawRequest{
+		Id:         1,
+		PoolId:     1,
+		MsgHeight:  90,
+		Withdrawer: withdrawer.String(),
+		PoolCoin:   sdk.NewInt64Coin("pool1", 1000),
+		Status:     types.RequestStatusNotExecuted,
+	}
+	store.Set(types.GetDepositRequestKey(1, 1), types.MustMarshalDepositRequest(cdc, depositReq))
+	store.Set(types.GetWithdrawRequestKey(1, 1), cdc.MustMarshal(&withdrawReq))
+
+	// Indexes in the v3 layout.
+	oldDepositIndexKey := append(
+		[]byte{0xb4}, types.GetDepositRequestIndexKey(depositor, 1, 1)[1:]...)
+	oldWithdrawIndexKey := append(
+		[]byte{0xb5}, types.GetWithdrawRequestIndexKey(withdrawer, 1, 1)[1:]...)
+	store.Set(oldDepositIndexKey, []byte{})
+	store.Set(oldWithdrawIndexKey, []byte{})
+
+	migrate(t, app, ctx)
+
+	require.False(t, store.Has(oldDepositIndexKey))
+	require.False(t, store.Has(oldWithdrawIndexKey))
+	require.True(t, store.Has(types.GetDepositRequestIndexKey(depositor, 1, 1)))
+	require.True(t, store.Has(types.GetWithdrawRequestIndexKey(withdrawer, 1, 1)))
+
+	k := app.LiquidityKeeper
+	require.Len(t, k.GetDepositRequestsByDepositor(ctx, depositor), 1)
+	require.Len(t, k.GetWithdrawRequestsByWithdrawer(ctx, withdrawer), 1)
+
+	// Only the finished request is queued to be deleted.
+	deletionHeight := ctx.BlockHeight() + 1 + int64(k.GetFinishedRecordRetentionBlocks(ctx))
+	require.True(t, store.Has(types.GetDepositRequestDeletionQueueKey(deletionHeight, 1, 1)))
+	require.False(t, store.Has(types.GetWithdrawRequestDeletionQueueKey(deletionHeight, 1, 1)))
+}
+
+func TestMigrateStore_OrderIndexes(t *testing.T) {
+	app, ctx := setupMigrationTest(t)
+	cdc := app.AppCodec()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	orderer := utils.TestAddress(0)
+
+	newOrder := func(id uint64, dir types.OrderDirection, price string, status types.OrderStatus) types.Order {
+		order := types.Order{
+			Type:               types.OrderTypeLimit,
+			Id:                 id,
+			PairId:             1,
+			MsgHeight:          90,
+			Orderer:            orderer.String(),
+			Direction:          dir,
+			OfferCoin:          sdk.NewInt64Coin("denom2", 10000),
+			RemainingOfferCoin: sdk.NewInt64Coin("denom2", 10000),
+			ReceivedCoin:       sdk.NewInt64Coin("denom1", 0),
+			Price:              utils.ParseDec(price),
+			Amount:             math.NewInt(10000),
+			OpenAmount:         math.NewInt(10000),
+			BatchId:            1,
+			ExpireAt:           utils.ParseTime("2022-01-02T00:00:00Z"),
+			Status:             status,
+		}
+		store.Set(types.GetOrderKey(order.PairId, order.Id), cdc.MustMarshal(&order))
+		return order
+	}
+	matchable := newOrder(1, types.OrderDirectionBuy, "1.0", types.OrderStatusNotExecuted)
+	completed := newOrder(2, types.OrderDirectionSell, "1.1", types.OrderStatusCompleted)
+
+	migrate(t, app, ctx)
+
+	deletionHeight := ctx.BlockHeight() + 1 + int64(app.LiquidityKeeper.GetFinishedRecordRetentionBlocks(ctx))
+
+	require.True(t, store.Has(types.GetOrderExpiryIndexKey(matchable.ExpireAt, 1, matchable.Id)))
+	require.True(t, store.Has(types.GetOrdersByPriceIndexKey(
+		1, matchable.Direction, matchable.Price, matchable.BatchId, matchable.Id)))
+	require.False(t, store.Has(types.GetOrderDeletionQueueKey(deletionHeight, 1, matchable.Id)))
+
+	require.False(t, store.Has(types.GetOrderExpiryIndexKey(completed.ExpireAt, 1, completed.Id)))
+	require.False(t, store.Has(types.GetOrdersByPriceIndexKey(
+		1, completed.Direction, completed.Price, completed.BatchId, completed.Id)))
+	require.True(t, store.Has(types.GetOrderDeletionQueueKey(deletionHeight, 1, completed.Id)))
+
+	// Orders can be queried by their fields.
+	querier := keeper.Querier{Keeper: app.LiquidityKeeper}
+	resp, err := querier.Orders(sdk.WrapSDKContext(ctx), &types.QueryOrdersRequest{
+		PairId: 1,
+		Status: types.OrderStatusCompleted,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Orders, 1)
+	require.Equal(t, completed.Id, resp.Orders[0].Id)
+	resp, err = querier.OrdersByOrderer(sdk.WrapSDKContext(ctx), &types.QueryOrdersByOrdererRequest{
+		Orderer:   orderer.String(),
+		Direction: types.OrderDirectionBuy,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Orders, 1)
+	require.Equal(t, matchable.Id, resp.Orders[0].Id)
+}
+
+func TestMigrateStore_MMOrderIndexes(t *testing.T) {
+	app, ctx := setupMigrationTest(t)
+	cdc := app.AppCodec()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	orderer := utils.TestAddress(0)
+
+	order := types.Order{
+		Type:      types.OrderTypeMM,
+		Id:        2,
+		PairId:    1,
+		Orderer:   orderer.String(),
+		Direction: types.OrderDirectionBuy,
+		Status:    types.OrderStatusCompleted,
+	}
+	store.Set(types.GetOrderKey(order.PairId, order.Id), cdc.MustMarshal(&order))
+
+	// The index of pair 1 has ids of deleted orders, and all orders in the
+	// index of pair 2 have been deleted.
+	staleIndex := types.MMOrderIndex{Orderer: orderer.String(), PairId: 1, OrderIds: []uint64{1, 2, 3}}
+	emptyIndex := types.MMOrderIndex{Orderer: orderer.String(), PairId: 2, OrderIds: []uint64{4}}
+	store.Set(types.GetMMOrderIndexKey(orderer, 1), cdc.MustMarshal(&staleIndex))
+	store.Set(types.GetMMOrderIndexKey(orderer, 2), cdc.MustMarshal(&emptyIndex))
+
+	migrate(t, app, ctx)
+
+	index, found := app.LiquidityKeeper.GetMMOrderIndex(ctx, orderer, 1)
+	require.True(t, found)
+	require.Equal(t, []uint64{2}, index.OrderIds)
+	require.False(t, store.Has(types.GetMMOrderIndexKey(orderer, 2)))
+}
@@ -49,6 +49,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &indexB)
 			return fmt.Sprintf("%v\n%v", indexA, indexB)
 
+		case bytes.Equal(kvA.Key[:1], types.ICARequestKeyPrefix):
+			var reqA, reqB types.ICARequest
+			cdc.MustUnmarshal(kvA.Value, &reqA)
+			cdc.MustUnmarshal(kvB.Value, &reqB)
+			return fmt.Sprintf("%v\n%v", reqA, reqB)
+
 		default:
 			panic(fmt.Sprintf("invalid liquidity key prefix %X", kvA.Key[:1]))
 		}
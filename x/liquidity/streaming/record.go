@@ -0,0 +1,104 @@
+package streaming
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"shogun/x/liquidity/types"
+)
+
+// RecordType is the type of the state object a Record holds.
+type RecordType string
+
+const (
+	RecordTypePair            RecordType = "pair"
+	RecordTypePool            RecordType = "pool"
+	RecordTypeDepositRequest  RecordType = "deposit_request"
+	RecordTypeWithdrawRequest RecordType = "withdraw_request"
+	RecordTypeOrder           RecordType = "order"
+	RecordTypeMMOrderIndex    RecordType = "mm_order_index"
+)
+
+// Record is a decoded write to the liquidity store.
+// Ids of the object are decoded from the key, so they are available even for
+// deletions, which don't have the value.
+type Record struct {
+	Height    int64           `json:"height"`
+	Type      RecordType      `json:"type"`
+	Key       string          `json:"key"` // hex-encoded
+	Delete    bool            `json:"delete"`
+	PairId    uint64          `json:"pair_id,omitempty"`
+	PoolId    uint64          `json:"pool_id,omitempty"`
+	RequestId uint64          `json:"request_id,omitempty"`
+	OrderId   uint64          `json:"order_id,omitempty"`
+	Orderer   string          `json:"orderer,omitempty"`
+	Value     json.RawMessage `json:"value,omitempty"` // the object in JSON, empty for deletions
+}
+
+// DecodeRecord decodes a write to the liquidity store.
+// It returns false if the key is not of an exported object, such as
+// indexes and params.
+func DecodeRecord(cdc codec.Codec, key, value []byte, delete bool) (rec Record, ok bool, err error) {
+	if len(key) == 0 {
+		return Record{}, false, nil
+	}
+	rec = Record{
+		Key:    hex.EncodeToString(key),
+		Delete: delete,
+	}
+
+	var msg codec.ProtoMarshaler
+	switch {
+	case bytes.HasPrefix(key, types.PairKeyPrefix):
+		rec.Type = RecordTypePair
+		rec.PairId = types.ParsePairKey(key)
+		msg = &types.Pair{}
+
+	case bytes.HasPrefix(key, types.PoolKeyPrefix):
+		rec.Type = RecordTypePool
+		rec.PoolId = types.ParsePoolKey(key)
+		msg = &types.Pool{}
+
+	case bytes.HasPrefix(key, types.DepositRequestKeyPrefix):
+		rec.Type = RecordTypeDepositRequest
+		rec.PoolId, rec.RequestId = types.ParseDepositRequestKey(key)
+		msg = &types.DepositRequest{}
+
+	case bytes.HasPrefix(key, types.WithdrawRequestKeyPrefix):
+		rec.Type = RecordTypeWithdrawRequest
+		rec.PoolId, rec.RequestId = types.ParseWithdrawRequestKey(key)
+		msg = &types.WithdrawRequest{}
+
+	case bytes.HasPrefix(key, types.OrderKeyPrefix):
+		rec.Type = RecordTypeOrder
+		rec.PairId, rec.OrderId = types.ParseOrderKey(key)
+		msg = &types.Order{}
+
+	case bytes.HasPrefix(key, types.MMOrderIndexKeyPrefix):
+		rec.Type = RecordTypeMMOrderIndex
+		var orderer sdk.AccAddress
+		orderer, rec.PairId = types.ParseMMOrderIndexKey(key)
+		rec.Orderer = orderer.String()
+		msg = &types.MMOrderIndex{}
+
+	default:
+		return Record{}, false, nil
+	}
+
+	if delete {
+		return rec, true, nil
+	}
+	if err := cdc.Unmarshal(value, msg); err != nil {
+		return Record{}, false, fmt.Errorf("unmarshal %s: %w", rec.Type, err)
+	}
+	rec.Value, err = cdc.MarshalJSON(msg)
+	if err != nil {
+		return Record{}, false, fmt.Errorf("marshal %s: %w", rec.Type, err)
+	}
+	return rec, true, nil
+}
@@ -0,0 +1,132 @@
+// Package streaming implements a state listener which exports writes to the
+// liquidity store, including deletions, to a Sink.
+package streaming
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"shogun/x/liquidity/types"
+)
+
+var (
+	_ baseapp.StreamingService = (*Service)(nil)
+	_ storetypes.WriteListener = (*Service)(nil)
+)
+
+// Service is a baseapp.StreamingService which listens to writes to the
+// liquidity store.
+// Writes are made to the store when a block is committed, so Service decodes
+// them into records as they happen and writes the records of the block to
+// the sink in ListenCommit.
+type Service struct {
+	storeKey    storetypes.StoreKey
+	cdc         codec.Codec
+	sink        Sink
+	logger      log.Logger
+	haltOnError bool
+
+	mu      sync.Mutex
+	records []Record
+	err     error // the first error occurred while decoding writes of the block
+}
+
+// NewService returns a new Service.
+// If haltOnError is true, an error while exporting records halts the app,
+// so that no record is missed. Otherwise, the error is only logged.
+func NewService(storeKey storetypes.StoreKey, cdc codec.Codec, sink Sink, logger log.Logger, haltOnError bool) *Service {
+	return &Service{
+		storeKey:    storeKey,
+		cdc:         cdc,
+		sink:        sink,
+		logger:      logger.With("module", fmt.Sprintf("x/%s", types.ModuleName)),
+		haltOnError: haltOnError,
+	}
+}
+
+// OnWrite implements storetypes.WriteListener.
+func (s *Service) OnWrite(_ storetypes.StoreKey, key, value []byte, delete bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil
+	}
+	rec, ok, err := DecodeRecord(s.cdc, key, value, delete)
+	if err != nil {
+		s.err = fmt.Errorf("decode key %X: %w", key, err)
+		return nil
+	}
+	if ok {
+		s.records = append(s.records, rec)
+	}
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (s *Service) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (s *Service) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (s *Service) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener.
+// It writes the records of the committed block to the sink.
+func (s *Service) ListenCommit(ctx context.Context, _ abci.ResponseCommit) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	records, err := s.records, s.err
+	s.records, s.err = nil, nil
+
+	if err == nil {
+		for i := range records {
+			records[i].Height = height
+		}
+		err = s.sink.Write(height, records)
+	}
+	if err != nil {
+		if s.haltOnError {
+			return fmt.Errorf("export liquidity state: %w", err)
+		}
+		s.logger.Error("failed to export liquidity state", "height", height, "error", err)
+	}
+	return nil
+}
+
+// Stream implements baseapp.StreamingService.
+// Service doesn't have its own loop, since records are written by
+// ListenCommit.
+func (s *Service) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService.
+func (s *Service) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{
+		s.storeKey: {s},
+	}
+}
+
+// Close implements baseapp.StreamingService.
+// It closes the sink.
+func (s *Service) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sink.Close()
+}
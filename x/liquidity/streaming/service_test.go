@@ -0,0 +1,137 @@
+package streaming_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	chain "shogun/app"
+	utils "shogun/types"
+	"shogun/x/liquidity/amm"
+	"shogun/x/liquidity/streaming"
+	"shogun/x/liquidity/types"
+)
+
+// memSink is a streaming.Sink which keeps records in memory.
+type memSink struct {
+	records map[int64][]streaming.Record
+}
+
+func (s *memSink) Write(height int64, records []streaming.Record) error {
+	s.records[height] = append(s.records[height], records...)
+	return nil
+}
+
+func (s *memSink) Close() error { return nil }
+
+func TestService(t *testing.T) {
+	a := chain.Setup(false)
+	// Commit the genesis state before the service starts listening.
+	chain.RunBlock(a, nil)
+
+	sink := &memSink{records: map[int64][]streaming.Record{}}
+	a.SetStreamingService(streaming.NewService(
+		a.GetKey(types.StoreKey), a.AppCodec(), sink, log.NewNopLogger(), true))
+
+	k := a.LiquidityKeeper
+	creator, orderer := utils.TestAddress(0), utils.TestAddress(1)
+
+	// Orders matched in the block are deleted in the next block, since the
+	// default retention period of finished records is zero.
+	var pair types.Pair
+	height := chain.RunBlock(a, func(ctx sdk.Context) {
+		var err error
+		require.NoError(t, chain.FundAccount(a.BankKeeper, ctx, creator, k.GetPairCreationFee(ctx)))
+		pair, err = k.CreatePair(ctx, types.NewMsgCreatePair(creator, "denom1", "denom2"))
+		require.NoError(t, err)
+		price, amt := utils.ParseDec("1.0"), math.NewInt(10000)
+		for _, dir := range []types.OrderDirection{types.OrderDirectionBuy, types.OrderDirectionSell} {
+			offerCoin := sdk.NewCoin("denom2", amm.OfferCoinAmount(amm.Buy, price, amt))
+			demandCoinDenom := "denom1"
+			if dir == types.OrderDirectionSell {
+				offerCoin, demandCoinDenom = sdk.NewCoin("denom1", amt), "denom2"
+			}
+			require.NoError(t, chain.FundAccount(a.BankKeeper, ctx, orderer, sdk.NewCoins(offerCoin)))
+			_, err := k.LimitOrder(ctx, types.NewMsgLimitOrder(
+				orderer, pair.Id, dir, offerCoin, demandCoinDenom, price, amt, time.Hour))
+			require.NoError(t, err)
+		}
+	})
+
+	byType := func(records []streaming.Record, typ streaming.RecordType) (res []streaming.Record) {
+		for _, rec := range records {
+			require.Equal(t, height, rec.Height)
+			if rec.Type == typ {
+				res = append(res, rec)
+			}
+		}
+		return
+	}
+
+	pairRecords := byType(sink.records[height], streaming.RecordTypePair)
+	require.Len(t, pairRecords, 1)
+	require.Equal(t, pair.Id, pairRecords[0].PairId)
+	var exportedPair types.Pair
+	require.NoError(t, a.AppCodec().UnmarshalJSON(pairRecords[0].Value, &exportedPair))
+	require.Equal(t, pair.EscrowAddress, exportedPair.EscrowAddress)
+	require.NotNil(t, exportedPair.LastPrice)
+
+	orderRecords := byType(sink.records[height], streaming.RecordTypeOrder)
+	require.Len(t, orderRecords, 2)
+	for _, rec := range orderRecords {
+		require.False(t, rec.Delete)
+		var order types.Order
+		require.NoError(t, a.AppCodec().UnmarshalJSON(rec.Value, &order))
+		require.Equal(t, rec.OrderId, order.Id)
+		require.Equal(t, types.OrderStatusCompleted, order.Status)
+	}
+
+	height = chain.RunBlock(a, nil)
+	orderRecords = byType(sink.records[height], streaming.RecordTypeOrder)
+	require.Len(t, orderRecords, 2)
+	for _, rec := range orderRecords {
+		require.True(t, rec.Delete)
+		require.Equal(t, pair.Id, rec.PairId)
+		require.NotZero(t, rec.OrderId)
+		require.Empty(t, rec.Value)
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "liquidity.jsonl")
+	records := []streaming.Record{
+		{Height: 1, Type: streaming.RecordTypePair, Key: "a50000000000000001", PairId: 1, Value: json.RawMessage(`{"id":"1"}`)},
+		{Height: 1, Type: streaming.RecordTypeOrder, Key: "b200000000000000010000000000000001", Delete: true, PairId: 1, OrderId: 1},
+	}
+
+	sink, err := streaming.NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(1, records[:1]))
+	require.NoError(t, sink.Close())
+	// Records are appended to the existing file.
+	sink, err = streaming.NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(1, records[1:]))
+	require.NoError(t, sink.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var got []streaming.Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec streaming.Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &rec))
+		got = append(got, rec)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, records, got)
+}
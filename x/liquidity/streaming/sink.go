@@ -0,0 +1,60 @@
+package streaming
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// Sink is the destination of records exported by Service.
+type Sink interface {
+	// Write writes the records of a committed block.
+	// It is called once per block, even if there are no records.
+	Write(height int64, records []Record) error
+	Close() error
+}
+
+var _ Sink = (*FileSink)(nil)
+
+// FileSink is a Sink which appends records to a file as JSON lines, one
+// record per line.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+	w    *bufio.Writer
+}
+
+// NewFileSink opens the file at path for appending records to it.
+// The file is created if it doesn't exist.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: file, w: bufio.NewWriter(file)}, nil
+}
+
+// Write implements Sink.
+// Records are flushed to the file before Write returns.
+func (s *FileSink) Write(_ int64, records []Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	enc := json.NewEncoder(s.w)
+	for _, rec := range records {
+		if err := enc.Encode(rec); err != nil {
+			return err
+		}
+	}
+	return s.w.Flush()
+}
+
+// Close implements Sink.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.w.Flush(); err != nil {
+		return err
+	}
+	return s.file.Close()
+}
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = (*TradingAuthorization)(nil)
+
+// NewTradingAuthorization returns a new TradingAuthorization.
+func NewTradingAuthorization(
+	msgTypeURL string, pairIds []uint64, dirs []OrderDirection, spendLimit sdk.Coins, period time.Duration) *TradingAuthorization {
+	return &TradingAuthorization{
+		MsgTypeUrl: msgTypeURL,
+		PairIds:    pairIds,
+		Directions: dirs,
+		SpendLimit: spendLimit,
+		Period:     period,
+	}
+}
+
+// MsgTypeURL implements authz.Authorization.
+func (a TradingAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements authz.Authorization.
+// It accepts the order if the pair and direction are allowed and the offer
+// coin doesn't exceed the spend limit of the current period, and updates the
+// coins spent in the period.
+// Orders forwarding their received coins over IBC are never accepted, since
+// the grantee could send the granter's coins anywhere.
+func (a TradingAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var (
+		pairId    uint64
+		dir       OrderDirection
+		offerCoin sdk.Coin
+		forward   *IBCForward
+	)
+	switch msg := msg.(type) {
+	case *MsgLimitOrder:
+		pairId, dir, offerCoin, forward = msg.PairId, msg.Direction, msg.OfferCoin, msg.Forward
+	case *MsgMarketOrder:
+		pairId, dir, offerCoin, forward = msg.PairId, msg.Direction, msg.OfferCoin, msg.Forward
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("type mismatch: %s", sdk.MsgTypeURL(msg))
+	}
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("type mismatch: %s", sdk.MsgTypeURL(msg))
+	}
+
+	if forward != nil {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("forwarding is not allowed")
+	}
+	if len(a.PairIds) > 0 && !containsPairId(a.PairIds, pairId) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("pair %d is not allowed", pairId)
+	}
+	if len(a.Directions) > 0 && !containsDirection(a.Directions, dir) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("direction %s is not allowed", dir)
+	}
+
+	if a.SpendLimit.Empty() {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+	blockTime := ctx.BlockTime()
+	if a.Period > 0 && !blockTime.Before(a.PeriodReset) {
+		a.PeriodSpent = nil
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+	spent := a.PeriodSpent.Add(offerCoin)
+	if !spent.IsAllLTE(a.SpendLimit) {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"offer coin %s exceeds the spend limit; spent %s of %s", offerCoin, a.PeriodSpent, a.SpendLimit)
+	}
+	a.PeriodSpent = spent
+	return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// ValidateBasic implements authz.Authorization.
+func (a TradingAuthorization) ValidateBasic() error {
+	switch a.MsgTypeUrl {
+	case sdk.MsgTypeURL(&MsgLimitOrder{}), sdk.MsgTypeURL(&MsgMarketOrder{}):
+	default:
+		return sdkerrors.ErrInvalidType.Wrapf("msg type url must be either limit order or market order: %s", a.MsgTypeUrl)
+	}
+	pairIdSet := map[uint64]struct{}{}
+	for _, pairId := range a.PairIds {
+		if pairId == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("pair id must not be 0")
+		}
+		if _, ok := pairIdSet[pairId]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate pair id: %d", pairId)
+		}
+		pairIdSet[pairId] = struct{}{}
+	}
+	dirSet := map[OrderDirection]struct{}{}
+	for _, dir := range a.Directions {
+		if dir != OrderDirectionBuy && dir != OrderDirectionSell {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid order direction: %s", dir)
+		}
+		if _, ok := dirSet[dir]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate order direction: %s", dir)
+		}
+		dirSet[dir] = struct{}{}
+	}
+	if err := a.SpendLimit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit: %v", err)
+	}
+	if a.Period < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("period must not be negative: %s", a.Period)
+	}
+	if err := a.PeriodSpent.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid period spent: %v", err)
+	}
+	return nil
+}
+
+func containsPairId(pairIds []uint64, pairId uint64) bool {
+	for _, id := range pairIds {
+		if id == pairId {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDirection(dirs []OrderDirection, dir OrderDirection) bool {
+	for _, d := range dirs {
+		if d == dir {
+			return true
+		}
+	}
+	return false
+}
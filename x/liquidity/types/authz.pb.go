@@ -0,0 +1,747 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crescent/liquidity/v1beta1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TradingAuthorization is an authz authorization allowing the grantee to make
+// orders of a message type on behalf of the granter, restricted to the pairs
+// and directions and capped in the cumulative offer amount per period.
+type TradingAuthorization struct {
+	// msg_type_url is the type url of the order message allowed; either
+	// MsgLimitOrder or MsgMarketOrder.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// pair_ids is the pairs allowed to trade in. All pairs are allowed if empty.
+	PairIds []uint64 `protobuf:"varint,2,rep,packed,name=pair_ids,json=pairIds,proto3" json:"pair_ids,omitempty"`
+	// directions is the order directions allowed. All directions are allowed if
+	// empty.
+	Directions []OrderDirection `protobuf:"varint,3,rep,packed,name=directions,proto3,enum=crescent.liquidity.v1beta1.OrderDirection" json:"directions,omitempty"`
+	// spend_limit is the maximum cumulative offer coins of the orders in a
+	// period. The offer amount isn't capped if empty, and only the denoms in
+	// spend_limit can be offered otherwise.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// period is the period after which period_spent is reset. The spend limit
+	// applies to the whole lifetime of the authorization if zero.
+	Period time.Duration `protobuf:"bytes,5,opt,name=period,proto3,stdduration" json:"period"`
+	// period_spent is the offer coins spent in the current period.
+	PeriodSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=period_spent,json=periodSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spent"`
+	// period_reset is the time at which the current period ends.
+	PeriodReset time.Time `protobuf:"bytes,7,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *TradingAuthorization) Reset()         { *m = TradingAuthorization{} }
+func (m *TradingAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TradingAuthorization) ProtoMessage()    {}
+func (*TradingAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbf2c146d3666bb5, []int{0}
+}
+func (m *TradingAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingAuthorization.Merge(m, src)
+}
+func (m *TradingAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingAuthorization proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*TradingAuthorization)(nil), "crescent.liquidity.v1beta1.TradingAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("crescent/liquidity/v1beta1/authz.proto", fileDescriptor_bbf2c146d3666bb5)
+}
+
+var fileDescriptor_bbf2c146d3666bb5 = []byte{
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3d, 0x8f, 0xd3, 0x3e,
+	0x00, 0xc6, 0x93, 0x7f, 0xfb, 0xef, 0x1d, 0xee, 0x89, 0x21, 0xba, 0x21, 0xd7, 0x21, 0x8d, 0x18,
+	0x50, 0x54, 0x09, 0x9b, 0x3b, 0x46, 0x26, 0xca, 0x49, 0xbc, 0x08, 0x09, 0x29, 0x94, 0x85, 0x25,
+	0x72, 0x62, 0xe3, 0x5a, 0x24, 0x71, 0xce, 0x2f, 0x88, 0xde, 0xca, 0x17, 0xb8, 0x91, 0xcf, 0xc0,
+	0x27, 0xe9, 0x78, 0x23, 0x13, 0x07, 0xed, 0x17, 0x41, 0x76, 0x92, 0x52, 0x81, 0x60, 0x62, 0xaa,
+	0xad, 0x3e, 0xcf, 0xef, 0x79, 0xf2, 0xc8, 0xe0, 0x6e, 0x21, 0xa9, 0x2a, 0x68, 0xad, 0x51, 0xc9,
+	0x2f, 0x0c, 0x27, 0x5c, 0xaf, 0xd0, 0xfb, 0xd3, 0x9c, 0x6a, 0x7c, 0x8a, 0xb0, 0xd1, 0xcb, 0x4b,
+	0xd8, 0x48, 0xa1, 0x45, 0x30, 0xe9, 0x75, 0x70, 0xa7, 0x83, 0x9d, 0x6e, 0x72, 0xcc, 0x04, 0x13,
+	0x4e, 0x86, 0xec, 0xa9, 0x75, 0x4c, 0xa2, 0x42, 0xa8, 0x4a, 0x28, 0x94, 0x63, 0x45, 0x77, 0xc8,
+	0x42, 0xf0, 0xba, 0xfb, 0x7f, 0xca, 0x84, 0x60, 0x25, 0x45, 0xee, 0x96, 0x9b, 0xb7, 0x48, 0xf3,
+	0x8a, 0x2a, 0x8d, 0xab, 0xa6, 0x07, 0xfc, 0x2a, 0x20, 0x46, 0x62, 0xcd, 0x45, 0x0f, 0x98, 0xfd,
+	0xa5, 0xfa, 0xcf, 0x92, 0x4e, 0x7b, 0xe7, 0xe3, 0x10, 0x1c, 0x2f, 0x24, 0x26, 0xbc, 0x66, 0x8f,
+	0x8c, 0x5e, 0x0a, 0xc9, 0x2f, 0x1d, 0x2a, 0x88, 0xc1, 0x51, 0xa5, 0x58, 0xa6, 0x57, 0x0d, 0xcd,
+	0x8c, 0x2c, 0x43, 0x3f, 0xf6, 0x93, 0x5b, 0x29, 0xa8, 0x14, 0x5b, 0xac, 0x1a, 0xfa, 0x5a, 0x96,
+	0xc1, 0x09, 0x38, 0x6c, 0x30, 0x97, 0x19, 0x27, 0x2a, 0xfc, 0x2f, 0x1e, 0x24, 0xc3, 0xf4, 0xc0,
+	0xde, 0x9f, 0x11, 0x15, 0x3c, 0x07, 0x80, 0x70, 0x49, 0x0b, 0x4b, 0x52, 0xe1, 0x20, 0x1e, 0x24,
+	0xb7, 0xcf, 0x66, 0xf0, 0xcf, 0x4b, 0xc1, 0x97, 0x92, 0x50, 0x79, 0xde, 0x5b, 0xd2, 0x3d, 0x77,
+	0x50, 0x82, 0xb1, 0x6a, 0x68, 0x4d, 0xb2, 0x92, 0x57, 0x5c, 0x87, 0xc3, 0x78, 0x90, 0x8c, 0xcf,
+	0x4e, 0x60, 0x3b, 0x22, 0xb4, 0x23, 0xee, 0x28, 0x8f, 0x05, 0xaf, 0xe7, 0xf7, 0xd7, 0x5f, 0xa7,
+	0xde, 0xe7, 0x9b, 0x69, 0xc2, 0xb8, 0x5e, 0x9a, 0x1c, 0x16, 0xa2, 0x42, 0xdd, 0xe2, 0xed, 0xcf,
+	0x3d, 0x45, 0xde, 0x21, 0xfb, 0x5d, 0xca, 0x19, 0x54, 0x0a, 0x1c, 0xff, 0x85, 0xc5, 0x07, 0x0f,
+	0xc1, 0xa8, 0xa1, 0x92, 0x0b, 0x12, 0xfe, 0x1f, 0xfb, 0x2e, 0xa8, 0x1d, 0x1b, 0xf6, 0x63, 0xc3,
+	0xf3, 0x6e, 0xec, 0xf9, 0xa1, 0x0d, 0xfa, 0x74, 0x33, 0xf5, 0xd3, 0xce, 0x12, 0xd4, 0xe0, 0xa8,
+	0x3d, 0x65, 0x96, 0xa8, 0xc3, 0xd1, 0xbf, 0xef, 0x3a, 0x6e, 0x03, 0x5e, 0x59, 0x7e, 0xf0, 0x64,
+	0x97, 0x27, 0xa9, 0xa2, 0x3a, 0x3c, 0x70, 0x95, 0x27, 0xbf, 0x55, 0x5e, 0xf4, 0x0f, 0xa8, 0xed,
+	0x7c, 0x65, 0x3b, 0x77, 0xa0, 0xd4, 0x1a, 0xe7, 0x4f, 0xd7, 0xdf, 0x23, 0x6f, 0xbd, 0x89, 0xfc,
+	0xeb, 0x4d, 0xe4, 0x7f, 0xdb, 0x44, 0xfe, 0xd5, 0x36, 0xf2, 0xae, 0xb7, 0x91, 0xf7, 0x65, 0x1b,
+	0x79, 0x6f, 0x66, 0x7b, 0xed, 0x2e, 0xb0, 0xc2, 0x46, 0x22, 0xb5, 0x14, 0xcc, 0xd4, 0xe8, 0xc3,
+	0xde, 0x0b, 0x73, 0x2d, 0xf3, 0x91, 0x0b, 0x7d, 0xf0, 0x63, 0x00, 0xce, 0x45, 0x4b, 0xfb, 0x3f,
+	0x03, 0x00, 0x00,
+}
+
+func (m *TradingAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.PeriodSpent) > 0 {
+		for iNdEx := len(m.PeriodSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Directions) > 0 {
+		dAtA4 := make([]byte, len(m.Directions)*10)
+		var j3 int
+		for _, num := range m.Directions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAuthz(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PairIds) > 0 {
+		dAtA6 := make([]byte, len(m.PairIds)*10)
+		var j5 int
+		for _, num := range m.PairIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintAuthz(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TradingAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.PairIds) > 0 {
+		l = 0
+		for _, e := range m.PairIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if len(m.Directions) > 0 {
+		l = 0
+		for _, e := range m.Directions {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpent) > 0 {
+		for _, e := range m.PeriodSpent {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TradingAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PairIds = append(m.PairIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PairIds) == 0 {
+					m.PairIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PairIds = append(m.PairIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIds", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v OrderDirection
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OrderDirection(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Directions = append(m.Directions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Directions) == 0 {
+					m.Directions = make([]OrderDirection, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v OrderDirection
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OrderDirection(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Directions = append(m.Directions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directions", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpent = append(m.PeriodSpent, types.Coin{})
+			if err := m.PeriodSpent[len(m.PeriodSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
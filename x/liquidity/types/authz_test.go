@@ -0,0 +1,129 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	utils "shogun/types"
+	"shogun/x/liquidity/types"
+)
+
+func TestTradingAuthorization_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(a *types.TradingAuthorization)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(a *types.TradingAuthorization) {},
+			"",
+		},
+		{
+			"no restrictions",
+			func(a *types.TradingAuthorization) {
+				a.PairIds = nil
+				a.Directions = nil
+				a.SpendLimit = nil
+				a.Period = 0
+			},
+			"",
+		},
+		{
+			"withdraw",
+			func(a *types.TradingAuthorization) {
+				a.MsgTypeUrl = sdk.MsgTypeURL(&types.MsgWithdraw{})
+			},
+			"msg type url must be either limit order or market order: /crescent.liquidity.v1beta1.MsgWithdraw: invalid type",
+		},
+		{
+			"zero pair id",
+			func(a *types.TradingAuthorization) {
+				a.PairIds = []uint64{0}
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"duplicate pair id",
+			func(a *types.TradingAuthorization) {
+				a.PairIds = []uint64{1, 2, 1}
+			},
+			"duplicate pair id: 1: invalid request",
+		},
+		{
+			"invalid direction",
+			func(a *types.TradingAuthorization) {
+				a.Directions = []types.OrderDirection{types.OrderDirectionUnspecified}
+			},
+			"invalid order direction: ORDER_DIRECTION_UNSPECIFIED: invalid request",
+		},
+		{
+			"duplicate direction",
+			func(a *types.TradingAuthorization) {
+				a.Directions = []types.OrderDirection{types.OrderDirectionBuy, types.OrderDirectionBuy}
+			},
+			"duplicate order direction: ORDER_DIRECTION_BUY: invalid request",
+		},
+		{
+			"invalid spend limit",
+			func(a *types.TradingAuthorization) {
+				a.SpendLimit = sdk.Coins{sdk.NewInt64Coin("denom1", 0)}
+			},
+			"invalid spend limit: coin 0denom1 amount is not positive: invalid coins",
+		},
+		{
+			"negative period",
+			func(a *types.TradingAuthorization) {
+				a.Period = -time.Hour
+			},
+			"period must not be negative: -1h0m0s: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := types.NewTradingAuthorization(
+				sdk.MsgTypeURL(&types.MsgLimitOrder{}), []uint64{1, 2},
+				[]types.OrderDirection{types.OrderDirectionBuy}, utils.ParseCoins("1000000denom1"), time.Hour)
+			tc.malleate(a)
+			err := a.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestTradingAuthorization_Accept(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Time: utils.ParseTime("2022-01-01T00:00:00Z")})
+	orderer := utils.TestAddress(0)
+	a := types.NewTradingAuthorization(
+		sdk.MsgTypeURL(&types.MsgMarketOrder{}), nil, nil, utils.ParseCoins("10000denom1,10000denom2"), 0)
+
+	marketOrder := types.NewMsgMarketOrder(
+		orderer, 1, types.OrderDirectionSell, utils.ParseCoin("10000denom1"), "denom2", sdk.NewInt(10000), 0)
+	resp, err := a.Accept(ctx, marketOrder)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	updated := resp.Updated.(*types.TradingAuthorization)
+	require.Equal(t, utils.ParseCoins("10000denom1"), updated.PeriodSpent)
+
+	// Without a period, the spend limit applies to the whole lifetime.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(365 * 24 * time.Hour))
+	_, err = updated.Accept(ctx, marketOrder)
+	require.EqualError(t, err, "offer coin 10000denom1 exceeds the spend limit; spent 10000denom1 of 10000denom1,10000denom2: insufficient funds")
+	// Denoms not in the spend limit can't be offered.
+	marketOrder.OfferCoin = utils.ParseCoin("10000denom3")
+	_, err = updated.Accept(ctx, marketOrder)
+	require.EqualError(t, err, "offer coin 10000denom3 exceeds the spend limit; spent 10000denom1 of 10000denom1,10000denom2: insufficient funds")
+
+	limitOrder := types.NewMsgLimitOrder(
+		orderer, 1, types.OrderDirectionSell, utils.ParseCoin("10000denom1"), "denom2",
+		utils.ParseDec("1.0"), sdk.NewInt(10000), 0)
+	_, err = a.Accept(ctx, limitOrder)
+	require.EqualError(t, err, "type mismatch: /crescent.liquidity.v1beta1.MsgLimitOrder: invalid type")
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/liquidity interfaces and concrete types
@@ -22,6 +23,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelOrder{}, "liquidity/MsgCancelOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelAllOrders{}, "liquidity/MsgCancelAllOrders", nil)
 	cdc.RegisterConcrete(&MsgCancelMMOrder{}, "liquidity/MsgCancelMMOrder", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "liquidity/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgRegisterICA{}, "liquidity/MsgRegisterICA", nil)
+	cdc.RegisterConcrete(&MsgICAMMOrder{}, "liquidity/MsgICAMMOrder", nil)
+	cdc.RegisterConcrete(&MsgICACancelMMOrder{}, "liquidity/MsgICACancelMMOrder", nil)
+	cdc.RegisterConcrete(&TradingAuthorization{}, "liquidity/TradingAuthorization", nil)
 }
 
 // RegisterInterfaces registers the x/liquidity interfaces types with the
@@ -40,6 +46,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCancelOrder{},
 		&MsgCancelAllOrders{},
 		&MsgCancelMMOrder{},
+		&MsgUpdateParams{},
+		&MsgRegisterICA{},
+		&MsgICAMMOrder{},
+		&MsgICACancelMMOrder{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&TradingAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -26,4 +26,7 @@ var (
 	ErrTooLargePool              = sdkerrors.Register(ModuleName, 18, "too large pool")
 	ErrTooManyPools              = sdkerrors.Register(ModuleName, 19, "too many pools in the pair")
 	ErrPriceNotOnTicks           = sdkerrors.Register(ModuleName, 20, "price is not on ticks")
+	ErrInvalidSwapMemo           = sdkerrors.Register(ModuleName, 21, "invalid swap memo")
+	ErrICANotFound               = sdkerrors.Register(ModuleName, 22, "interchain account not found")
+	ErrFeeNotConvertible         = sdkerrors.Register(ModuleName, 23, "fee coin cannot be converted to the native coin")
 )
@@ -1,6 +1,11 @@
 package types
 
 // Event types for the liquidity module.
+//
+// Deprecated: These events with string attributes are superseded by the typed
+// events defined in events.proto, and are emitted only if the
+// liquidity.emit-legacy-events app config option is enabled.
+// They will be removed in the next release.
 const (
 	EventTypeCreatePair       = "create_pair"
 	EventTypeCreatePool       = "create_pool"
@@ -18,6 +23,7 @@ const (
 	EventTypeOrderResult      = "order_result"
 	EventTypeUserOrderMatched = "user_order_matched"
 	EventTypePoolOrderMatched = "pool_order_matched"
+	EventTypeBatchExecuted    = "batch_executed"
 
 	AttributeKeyCreator            = "creator"
 	AttributeKeyDepositor          = "depositor"
@@ -53,4 +59,12 @@ const (
 	AttributeKeyStatus             = "status"
 	AttributeKeyMatchedAmount      = "matched_amount"
 	AttributeKeyPaidCoin           = "paid_coin"
+	AttributeKeyMatched            = "matched"
+	AttributeKeyMatchPrice         = "match_price"
+	AttributeKeyPriceDirection     = "price_direction"
+	AttributeKeyMatchedBaseAmount  = "matched_base_amount"
+	AttributeKeyMatchedQuoteAmount = "matched_quote_amount"
+	AttributeKeyNumFilledOrders    = "num_filled_orders"
+	AttributeKeyDust               = "dust"
+	AttributeKeyLastPrice          = "last_price"
 )
@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"os"
+
 	"cosmossdk.io/math"
 
 	utils "shogun/types"
@@ -29,7 +31,7 @@ func ExampleMakeOrderBookPairResponse() {
 		PriceUnitPower: 0,
 		MaxNumTicks:    20,
 	})
-	types.PrintOrderBookResponse(resp.OrderBooks[0], resp.BasePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[0], resp.BasePrice)
 
 	// Output:
 	// +------------------------------------------------------------------------+
@@ -69,7 +71,7 @@ func ExampleMakeOrderBookPairResponse_pool() {
 		PriceUnitPower: 0,
 		MaxNumTicks:    10,
 	})
-	types.PrintOrderBookResponse(resp.OrderBooks[0], resp.BasePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[0], resp.BasePrice)
 
 	// Output:
 	// +------------------------------------------------------------------------+
@@ -127,7 +129,7 @@ func ExampleMakeOrderBookPairResponse_userOrder() {
 	if !found {
 		panic("base price not found")
 	}
-	types.PrintOrderBookResponse(resp.OrderBooks[0], basePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[0], basePrice)
 
 	// Output:
 	// +------------------------------------------------------------------------+
@@ -176,7 +178,7 @@ func ExampleMakeOrderBookPairResponse_match() {
 	if !found {
 		panic("base price not found")
 	}
-	types.PrintOrderBookResponse(resp.OrderBooks[0], basePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[0], basePrice)
 
 	// Output:
 	// +------------------------------------------------------------------------+
@@ -204,7 +206,7 @@ func ExampleMakeOrderBookPairResponse_zigzag() {
 		PriceUnitPower: 0,
 		MaxNumTicks:    20,
 	})
-	types.PrintOrderBookResponse(resp.OrderBooks[0], basePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[0], basePrice)
 
 	// Output:
 	// +------------------------------------------------------------------------+
@@ -236,7 +238,7 @@ func ExampleMakeOrderBookPairResponse_edgecase1() {
 		PriceUnitPower: 0,
 		MaxNumTicks:    10,
 	})
-	types.PrintOrderBookResponse(resp.OrderBooks[0], basePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[0], basePrice)
 
 	// Output:
 	// +------------------------------------------------------------------------+
@@ -282,7 +284,7 @@ func ExampleMakeOrderBookPairResponse_edgecase2() {
 		PriceUnitPower: 0,
 		MaxNumTicks:    10,
 	})
-	types.PrintOrderBookResponse(resp.OrderBooks[0], resp.BasePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[0], resp.BasePrice)
 
 	// Output:
 	// +------------------------------------------------------------------------+
@@ -308,7 +310,7 @@ func ExampleMakeOrderBookPairResponse_edgecase3() {
 		PriceUnitPower: 0,
 		MaxNumTicks:    10,
 	})
-	types.PrintOrderBookResponse(resp.OrderBooks[0], resp.BasePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[0], resp.BasePrice)
 
 	// Output:
 	// +------------------------------------------------------------------------+
@@ -345,9 +347,9 @@ func ExampleMakeOrderBookPairResponse_priceUnits1() {
 			MaxNumTicks:    10,
 		},
 	)
-	types.PrintOrderBookResponse(resp.OrderBooks[0], resp.BasePrice)
-	types.PrintOrderBookResponse(resp.OrderBooks[1], resp.BasePrice)
-	types.PrintOrderBookResponse(resp.OrderBooks[2], resp.BasePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[0], resp.BasePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[1], resp.BasePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[2], resp.BasePrice)
 
 	// Output:
 	// +------------------------------------------------------------------------+
@@ -452,9 +454,9 @@ func ExampleMakeOrderBookPairResponse_priceUnits2() {
 			MaxNumTicks:    10,
 		},
 	)
-	types.PrintOrderBookResponse(resp.OrderBooks[0], resp.BasePrice)
-	types.PrintOrderBookResponse(resp.OrderBooks[1], resp.BasePrice)
-	types.PrintOrderBookResponse(resp.OrderBooks[2], resp.BasePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[0], resp.BasePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[1], resp.BasePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[2], resp.BasePrice)
 
 	// Output:
 	// +------------------------------------------------------------------------+
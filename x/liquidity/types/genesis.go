@@ -16,6 +16,7 @@ func DefaultGenesis() *GenesisState {
 		WithdrawRequests:         []WithdrawRequest{},
 		Orders:                   []Order{},
 		MarketMakingOrderIndexes: []MMOrderIndex{},
+		ICARequests:              []ICARequest{},
 	}
 }
 
@@ -135,5 +136,19 @@ func (genState GenesisState) Validate() error {
 		}
 		orderSet[order.PairId][order.Id] = struct{}{}
 	}
+	icaReqSet := map[string]map[uint64]struct{}{}
+	for i, req := range genState.ICARequests {
+		if err := req.Validate(); err != nil {
+			return fmt.Errorf("invalid ica request at index %d: %w", i, err)
+		}
+		if set, ok := icaReqSet[req.ChannelId]; ok {
+			if _, ok := set[req.Sequence]; ok {
+				return fmt.Errorf("ica request at index %d has a duplicate sequence: %d", i, req.Sequence)
+			}
+		} else {
+			icaReqSet[req.ChannelId] = map[uint64]struct{}{}
+		}
+		icaReqSet[req.ChannelId][req.Sequence] = struct{}{}
+	}
 	return nil
 }
@@ -34,6 +34,7 @@ type GenesisState struct {
 	WithdrawRequests         []WithdrawRequest `protobuf:"bytes,7,rep,name=withdraw_requests,json=withdrawRequests,proto3" json:"withdraw_requests"`
 	Orders                   []Order           `protobuf:"bytes,8,rep,name=orders,proto3" json:"orders"`
 	MarketMakingOrderIndexes []MMOrderIndex    `protobuf:"bytes,9,rep,name=market_making_order_indexes,json=marketMakingOrderIndexes,proto3" json:"market_making_order_indexes"`
+	ICARequests              []ICARequest      `protobuf:"bytes,10,rep,name=ica_requests,json=icaRequests,proto3" json:"ica_requests"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,35 +79,36 @@ func init() {
 }
 
 var fileDescriptor_6a6239844d27c73b = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0x80, 0x13, 0xbb, 0x5d, 0x75, 0x5a, 0xb0, 0x06, 0x0f, 0xc3, 0x0a, 0x31, 0xf6, 0x14, 0x2a,
-	0x26, 0xb4, 0x7a, 0x11, 0x04, 0xa5, 0x08, 0xb2, 0x87, 0xc5, 0xb2, 0x1e, 0x0a, 0x0a, 0x86, 0xd9,
-	0x9d, 0x47, 0x3a, 0x6c, 0x92, 0x97, 0xce, 0x9b, 0xed, 0xb6, 0xff, 0xc2, 0x9f, 0xb5, 0xc7, 0x1e,
-	0x3d, 0x89, 0xee, 0xfe, 0x0e, 0x41, 0x32, 0xc9, 0xee, 0x76, 0x0f, 0x06, 0x6f, 0xe1, 0xe5, 0xfb,
-	0xbe, 0x37, 0x30, 0xc3, 0xc2, 0xb1, 0x06, 0x1a, 0x43, 0x61, 0xe2, 0x4c, 0x5d, 0x4e, 0x95, 0x54,
-	0xe6, 0x26, 0xbe, 0x3a, 0x1e, 0x81, 0x11, 0xc7, 0x71, 0x0a, 0x05, 0x90, 0xa2, 0xa8, 0xd4, 0x68,
-	0xd0, 0xeb, 0xad, 0xc8, 0x68, 0x4d, 0x46, 0x0d, 0xd9, 0x7b, 0x92, 0x62, 0x8a, 0x16, 0x8b, 0xab,
-	0xaf, 0xda, 0xe8, 0x1d, 0xb5, 0xb4, 0x37, 0x0d, 0xcb, 0x1e, 0xfe, 0xe9, 0xb0, 0xfd, 0x8f, 0xf5,
-	0xbe, 0xcf, 0x46, 0x18, 0xf0, 0xde, 0xb3, 0x6e, 0x29, 0xb4, 0xc8, 0x89, 0xbb, 0x81, 0x1b, 0xee,
-	0x9d, 0x1c, 0x46, 0xff, 0xde, 0x1f, 0x9d, 0x59, 0xf2, 0xb4, 0x33, 0xff, 0xf9, 0xcc, 0x19, 0x36,
-	0x9e, 0x17, 0xb0, 0xfd, 0x4c, 0x90, 0x49, 0x4a, 0xa1, 0x74, 0xa2, 0x24, 0xbf, 0x17, 0xb8, 0x61,
-	0x67, 0xc8, 0xaa, 0xd9, 0x99, 0x50, 0xba, 0x2f, 0x37, 0x04, 0x62, 0x56, 0x11, 0x3b, 0x77, 0x08,
-	0xc4, 0xac, 0x2f, 0xbd, 0xb7, 0x6c, 0xb7, 0xd2, 0x89, 0x77, 0x82, 0x9d, 0x70, 0xef, 0x24, 0x68,
-	0x3f, 0x84, 0xd2, 0xcd, 0x11, 0x6a, 0xc9, 0xda, 0x88, 0x19, 0xf1, 0xdd, 0xff, 0xb0, 0x11, 0xb3,
-	0xb5, 0x5d, 0x49, 0xde, 0x57, 0x76, 0x20, 0xa1, 0x44, 0x52, 0x26, 0xd1, 0x70, 0x39, 0x05, 0x32,
-	0xc4, 0xbb, 0x36, 0x74, 0xd4, 0x16, 0xfa, 0x50, 0x3b, 0xc3, 0x5a, 0x69, 0x92, 0x8f, 0xe4, 0xd6,
-	0x94, 0xbc, 0x6f, 0xec, 0xf1, 0x4c, 0x99, 0x0b, 0xa9, 0xc5, 0x6c, 0x53, 0xbf, 0x6f, 0xeb, 0x2f,
-	0xda, 0xea, 0xe7, 0x8d, 0xb4, 0x9d, 0x3f, 0x98, 0x6d, 0x8f, 0xc9, 0x7b, 0xc7, 0xba, 0xa8, 0x25,
-	0x68, 0xe2, 0x0f, 0x6c, 0xf4, 0x79, 0x5b, 0xf4, 0x53, 0x45, 0xae, 0x6e, 0xaf, 0xd6, 0xbc, 0x9c,
-	0x3d, 0xcd, 0x85, 0x9e, 0x80, 0x49, 0x72, 0x31, 0x51, 0x45, 0x9a, 0xd8, 0x79, 0xa2, 0x0a, 0x09,
-	0xd7, 0x40, 0xfc, 0xa1, 0xad, 0x86, 0x6d, 0xd5, 0xc1, 0xc0, 0x76, 0xfb, 0x95, 0xd1, 0xc4, 0x79,
-	0x9d, 0x1c, 0xd8, 0xe2, 0xe6, 0x2f, 0xd0, 0xe9, 0xf9, 0xfc, 0xb7, 0xef, 0xcc, 0x17, 0xbe, 0x7b,
-	0xbb, 0xf0, 0xdd, 0x5f, 0x0b, 0xdf, 0xfd, 0xbe, 0xf4, 0x9d, 0xdb, 0xa5, 0xef, 0xfc, 0x58, 0xfa,
-	0xce, 0x97, 0x37, 0xa9, 0x32, 0x17, 0xd3, 0x51, 0x34, 0xc6, 0x3c, 0x5e, 0x6d, 0x7c, 0x59, 0x80,
-	0x99, 0xa1, 0x9e, 0xac, 0x07, 0xf1, 0xd5, 0xeb, 0xf8, 0xfa, 0xce, 0x53, 0x37, 0x37, 0x25, 0xd0,
-	0xa8, 0x6b, 0xdf, 0xf7, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xd1, 0x6a, 0x18, 0x69,
-	0x03, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0x80, 0x13, 0xd6, 0x16, 0x70, 0x2b, 0x31, 0x02, 0x07, 0xab, 0x48, 0x59, 0xd8, 0x01, 0x45,
+	0x45, 0x4a, 0xb4, 0x71, 0x45, 0x02, 0x06, 0x12, 0xf4, 0x50, 0x31, 0x95, 0x03, 0x12, 0x48, 0x44,
+	0x6e, 0x6c, 0xa5, 0xd6, 0x92, 0x38, 0xf5, 0xef, 0xd0, 0xed, 0x2d, 0x78, 0xac, 0x1e, 0x77, 0xe4,
+	0xc2, 0x04, 0xed, 0x8b, 0x20, 0x3b, 0xe9, 0xd2, 0x1e, 0x88, 0xb8, 0x25, 0x7f, 0xbe, 0xef, 0xb3,
+	0xe5, 0xc8, 0xc8, 0x8f, 0x25, 0x83, 0x98, 0xe5, 0x2a, 0x4c, 0xf9, 0xa2, 0xe4, 0x94, 0xab, 0xab,
+	0xf0, 0xfb, 0xc9, 0x8c, 0x29, 0x72, 0x12, 0x26, 0x2c, 0x67, 0xc0, 0x21, 0x28, 0xa4, 0x50, 0xc2,
+	0x19, 0x6e, 0xc9, 0xe0, 0x96, 0x0c, 0x6a, 0x72, 0xf8, 0x38, 0x11, 0x89, 0x30, 0x58, 0xa8, 0x9f,
+	0x2a, 0x63, 0x38, 0x6a, 0x69, 0x37, 0x0d, 0xc3, 0x1e, 0xff, 0xea, 0xa2, 0xc1, 0xfb, 0x6a, 0xbd,
+	0x4f, 0x8a, 0x28, 0xe6, 0xbc, 0x46, 0xbd, 0x82, 0x48, 0x92, 0x01, 0xb6, 0x3d, 0xdb, 0xef, 0x9f,
+	0x1e, 0x07, 0xff, 0x5e, 0x3f, 0x38, 0x37, 0xe4, 0x59, 0x67, 0x75, 0x73, 0x64, 0x4d, 0x6b, 0xcf,
+	0xf1, 0xd0, 0x20, 0x25, 0xa0, 0xa2, 0x82, 0x70, 0x19, 0x71, 0x8a, 0xef, 0x78, 0xb6, 0xdf, 0x99,
+	0x22, 0x3d, 0x3b, 0x27, 0x5c, 0x8e, 0x69, 0x43, 0x08, 0x91, 0x6a, 0xe2, 0x60, 0x87, 0x10, 0x22,
+	0x1d, 0x53, 0xe7, 0x25, 0xea, 0x6a, 0x1d, 0x70, 0xc7, 0x3b, 0xf0, 0xfb, 0xa7, 0x5e, 0xfb, 0x26,
+	0xb8, 0xac, 0xb7, 0x50, 0x49, 0xc6, 0x16, 0x22, 0x05, 0xdc, 0xfd, 0x0f, 0x5b, 0x88, 0xf4, 0xd6,
+	0xd6, 0x92, 0xf3, 0x15, 0x1d, 0x52, 0x56, 0x08, 0xe0, 0x2a, 0x92, 0x6c, 0x51, 0x32, 0x50, 0x80,
+	0x7b, 0x26, 0x34, 0x6a, 0x0b, 0xbd, 0xab, 0x9c, 0x69, 0xa5, 0xd4, 0xc9, 0x07, 0x74, 0x6f, 0x0a,
+	0xce, 0x37, 0xf4, 0x70, 0xc9, 0xd5, 0x9c, 0x4a, 0xb2, 0x6c, 0xea, 0x77, 0x4d, 0xfd, 0x79, 0x5b,
+	0xfd, 0x73, 0x2d, 0xed, 0xe7, 0x0f, 0x97, 0xfb, 0x63, 0x70, 0x5e, 0xa1, 0x9e, 0x90, 0x94, 0x49,
+	0xc0, 0xf7, 0x4c, 0xf4, 0x69, 0x5b, 0xf4, 0xa3, 0x26, 0xb7, 0x7f, 0xaf, 0xd2, 0x9c, 0x0c, 0x3d,
+	0xc9, 0x88, 0xbc, 0x60, 0x2a, 0xca, 0xc8, 0x05, 0xcf, 0x93, 0xc8, 0xcc, 0x23, 0x9e, 0x53, 0x76,
+	0xc9, 0x00, 0xdf, 0x37, 0x55, 0xbf, 0xad, 0x3a, 0x99, 0x98, 0xee, 0x58, 0x1b, 0x75, 0x1c, 0x57,
+	0xc9, 0x89, 0x29, 0x36, 0x5f, 0x99, 0x3e, 0x8f, 0x01, 0x8f, 0x49, 0x73, 0x14, 0xc8, 0xf4, 0x9f,
+	0xb5, 0xf5, 0xc7, 0x6f, 0xdf, 0x6c, 0x4f, 0xe1, 0x91, 0xae, 0xaf, 0x6f, 0x8e, 0xfa, 0xcd, 0x0c,
+	0xa6, 0x7d, 0x1e, 0x93, 0xed, 0xcb, 0xd9, 0x87, 0xd5, 0x1f, 0xd7, 0x5a, 0xad, 0x5d, 0xfb, 0x7a,
+	0xed, 0xda, 0xbf, 0xd7, 0xae, 0xfd, 0x63, 0xe3, 0x5a, 0xd7, 0x1b, 0xd7, 0xfa, 0xb9, 0x71, 0xad,
+	0x2f, 0xa3, 0x84, 0xab, 0x79, 0x39, 0x0b, 0x62, 0x91, 0x85, 0x0b, 0x02, 0xa4, 0x94, 0x21, 0xcc,
+	0x45, 0x52, 0xe6, 0xe1, 0xe5, 0xce, 0xdd, 0x51, 0x57, 0x05, 0x83, 0x59, 0xcf, 0x5c, 0x98, 0x17,
+	0x7f, 0x07, 0x00, 0x21, 0xcd, 0xa5, 0x68, 0xba, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +131,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ICARequests) > 0 {
+		for iNdEx := len(m.ICARequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ICARequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.MarketMakingOrderIndexes) > 0 {
 		for iNdEx := len(m.MarketMakingOrderIndexes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -297,6 +313,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ICARequests) > 0 {
+		for _, e := range m.ICARequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -610,6 +632,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ICARequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ICARequests = append(m.ICARequests, ICARequest{})
+			if err := m.ICARequests[len(m.ICARequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -49,6 +49,8 @@ func TestGenesisState_Validate(t *testing.T) {
 		ExpireAt:           utils.ParseTime("2022-02-01T00:00:00Z"),
 		Status:             types.OrderStatusPartiallyMatched,
 	}
+	icaReq := types.NewICARequest(
+		"channel-0", 1, testAddr, "connection-0", &types.MsgCancelMMOrder{}, 1)
 
 	for _, tc := range []struct {
 		name        string
@@ -222,6 +224,20 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"order at index 1 has a duplicate id: 1",
 		},
+		{
+			"invalid ica request",
+			func(genState *types.GenesisState) {
+				genState.ICARequests[0].Sequence = 0
+			},
+			"invalid ica request at index 0: sequence must not be 0",
+		},
+		{
+			"duplicate ica request",
+			func(genState *types.GenesisState) {
+				genState.ICARequests = []types.ICARequest{icaReq, icaReq}
+			},
+			"ica request at index 1 has a duplicate sequence: 1",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			genState := types.DefaultGenesis()
@@ -232,6 +248,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState.DepositRequests = []types.DepositRequest{depositReq}
 			genState.WithdrawRequests = []types.WithdrawRequest{withdrawReq}
 			genState.Orders = []types.Order{order}
+			genState.ICARequests = []types.ICARequest{icaReq}
 			tc.malleate(genState)
 			err := genState.Validate()
 			if tc.expectedErr == "" {
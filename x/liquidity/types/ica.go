@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// NewICARequest returns a new pending ICARequest.
+func NewICARequest(
+	channelId string, sequence uint64, owner sdk.AccAddress, connectionId string, msg sdk.Msg, pairId uint64) ICARequest {
+	return ICARequest{
+		ChannelId:    channelId,
+		Sequence:     sequence,
+		Owner:        owner.String(),
+		ConnectionId: connectionId,
+		MsgTypeUrl:   sdk.MsgTypeURL(msg),
+		PairId:       pairId,
+		Status:       ICARequestStatusPending,
+	}
+}
+
+// Validate validates ICARequest for genesis.
+func (req ICARequest) Validate() error {
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel id: %s", err.Error())
+	}
+	if req.Sequence == 0 {
+		return fmt.Errorf("sequence must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %s: %w", req.Owner, err)
+	}
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return fmt.Errorf("invalid connection id: %s", err.Error())
+	}
+	if req.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	switch req.Status {
+	case ICARequestStatusPending, ICARequestStatusSucceeded, ICARequestStatusFailed, ICARequestStatusTimedOut:
+	default:
+		return fmt.Errorf("invalid status: %s", req.Status)
+	}
+	return nil
+}
+
+// MustMarshalICARequest returns the ICARequest bytes.
+// It throws panic if it fails.
+func MustMarshalICARequest(cdc codec.BinaryCodec, req ICARequest) []byte {
+	return cdc.MustMarshal(&req)
+}
+
+// UnmarshalICARequest returns the ICARequest from bytes.
+func UnmarshalICARequest(cdc codec.BinaryCodec, value []byte) (req ICARequest, err error) {
+	err = cdc.Unmarshal(value, &req)
+	return req, err
+}
+
+// MustUnmarshalICARequest returns the ICARequest from bytes.
+// It throws panic if it fails.
+func MustUnmarshalICARequest(cdc codec.BinaryCodec, value []byte) ICARequest {
+	req, err := UnmarshalICARequest(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return req
+}
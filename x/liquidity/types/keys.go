@@ -2,6 +2,11 @@ package types
 
 import (
 	"bytes"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -22,6 +27,8 @@ const (
 )
 
 var (
+	ParamsKey = []byte{0x01} // key for the module parameters
+
 	LastPairIdKey = []byte{0xa0} // key for the latest pair id
 	LastPoolIdKey = []byte{0xa1} // key for the latest pool id
 
@@ -33,15 +40,61 @@ var (
 	PoolByReserveAddressIndexKeyPrefix = []byte{0xac}
 	PoolsByPairIndexKeyPrefix          = []byte{0xad}
 
-	DepositRequestKeyPrefix       = []byte{0xb0}
-	DepositRequestIndexKeyPrefix  = []byte{0xb4} // TODO: rearrange prefixes
-	WithdrawRequestKeyPrefix      = []byte{0xb1}
-	WithdrawRequestIndexKeyPrefix = []byte{0xb5}
-	OrderKeyPrefix                = []byte{0xb2}
-	OrderIndexKeyPrefix           = []byte{0xb3}
-	MMOrderIndexKeyPrefix         = []byte{0xb6}
+	DepositRequestKeyPrefix     = []byte{0xb0}
+	WithdrawRequestKeyPrefix    = []byte{0xb1}
+	OrderKeyPrefix              = []byte{0xb2}
+	OrderIndexKeyPrefix         = []byte{0xb3}
+	MMOrderIndexKeyPrefix       = []byte{0xb6}
+	OrderExpiryIndexKeyPrefix   = []byte{0xb7}
+	OrdersByPriceIndexKeyPrefix = []byte{0xbb}
+
+	// Indexes of requests by their owners, which used to be 0xb4 and 0xb5
+	// until v4.
+	DepositRequestIndexKeyPrefix  = []byte{0xbc}
+	WithdrawRequestIndexKeyPrefix = []byte{0xbd}
+
+	OrderDeletionQueueKeyPrefix           = []byte{0xb8}
+	DepositRequestDeletionQueueKeyPrefix  = []byte{0xb9}
+	WithdrawRequestDeletionQueueKeyPrefix = []byte{0xba}
+
+	ICARequestKeyPrefix = []byte{0xbe}
+
+	OrdersByFieldIndexKeyPrefix        = []byte{0xbf}
+	OrdersByOrdererFieldIndexKeyPrefix = []byte{0xc0}
+)
+
+// OrderIndexField is a field of orders which orders are indexed by, so that
+// orders can be filtered by the field without iterating all of them.
+type OrderIndexField byte
+
+const (
+	OrderIndexFieldStatus OrderIndexField = iota + 1
+	OrderIndexFieldDirection
+	OrderIndexFieldType
 )
 
+// OrderIndexFields are the fields which orders are indexed by.
+var OrderIndexFields = []OrderIndexField{
+	OrderIndexFieldStatus,
+	OrderIndexFieldDirection,
+	OrderIndexFieldType,
+}
+
+// OrderIndexFieldValue returns the value of the order's field which the order
+// is indexed by.
+func OrderIndexFieldValue(order Order, field OrderIndexField) byte {
+	switch field {
+	case OrderIndexFieldStatus:
+		return byte(order.Status)
+	case OrderIndexFieldDirection:
+		return byte(order.Direction)
+	case OrderIndexFieldType:
+		return byte(order.Type)
+	default:
+		panic(fmt.Errorf("invalid order index field: %d", field))
+	}
+}
+
 // GetPairKey returns the store key to retrieve pair object from the pair id.
 func GetPairKey(pairId uint64) []byte {
 	return append(PairKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
@@ -145,10 +198,188 @@ func GetOrderIndexKeyPrefix(orderer sdk.AccAddress) []byte {
 	return append(OrderIndexKeyPrefix, address.MustLengthPrefix(orderer)...)
 }
 
+// GetOrderIndexKeyPrefixByPair returns the index key prefix to iterate orders
+// by an orderer in a pair.
+func GetOrderIndexKeyPrefixByPair(orderer sdk.AccAddress, pairId uint64) []byte {
+	return append(GetOrderIndexKeyPrefix(orderer), sdk.Uint64ToBigEndian(pairId)...)
+}
+
 // GetMMOrderIndexKey returns the store key to retrieve MMOrderIndex object by
 // orderer and pair id.
 func GetMMOrderIndexKey(orderer sdk.AccAddress, pairId uint64) []byte {
-	return append(append(MMOrderIndexKeyPrefix, address.MustLengthPrefix(orderer)...), sdk.Uint64ToBigEndian(pairId)...)
+	return append(GetMMOrderIndexKeyPrefix(orderer), sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetMMOrderIndexKeyPrefix returns the key prefix to iterate MMOrderIndex
+// objects by orderer.
+func GetMMOrderIndexKeyPrefix(orderer sdk.AccAddress) []byte {
+	return append(MMOrderIndexKeyPrefix, address.MustLengthPrefix(orderer)...)
+}
+
+// GetICARequestKey returns the store key to retrieve ICA request object from
+// the channel id and packet sequence.
+func GetICARequestKey(channelId string, sequence uint64) []byte {
+	return append(append(ICARequestKeyPrefix, LengthPrefixString(channelId)...), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetOrderExpiryIndexKey returns the index key to iterate orders by their
+// expiration time.
+func GetOrderExpiryIndexKey(expireAt time.Time, pairId, orderId uint64) []byte {
+	return append(append(GetOrderExpiryIndexKeyPrefix(expireAt), sdk.Uint64ToBigEndian(pairId)...), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetOrderExpiryIndexKeyPrefix returns the index key prefix to iterate orders
+// expiring at the given time.
+func GetOrderExpiryIndexKeyPrefix(expireAt time.Time) []byte {
+	return append(OrderExpiryIndexKeyPrefix, sdk.FormatTimeBytes(expireAt)...)
+}
+
+// GetOrdersByPriceIndexKey returns the index key to iterate orders within
+// the pair in the order of their price.
+func GetOrdersByPriceIndexKey(pairId uint64, dir OrderDirection, price math.LegacyDec, batchId, orderId uint64) []byte {
+	return append(append(append(GetOrdersByPriceIndexKeyPrefix(pairId, dir), SortablePriceBytes(price)...),
+		sdk.Uint64ToBigEndian(batchId)...), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetOrdersByPriceIndexKeyPrefix returns the index key prefix to iterate
+// orders with the direction within the pair.
+func GetOrdersByPriceIndexKeyPrefix(pairId uint64, dir OrderDirection) []byte {
+	return append(append(OrdersByPriceIndexKeyPrefix, sdk.Uint64ToBigEndian(pairId)...), byte(dir))
+}
+
+// GetOrdersByPriceIndexKeyPrefixWithPrice returns the index key prefix to
+// iterate orders with the direction and the price within the pair.
+func GetOrdersByPriceIndexKeyPrefixWithPrice(pairId uint64, dir OrderDirection, price math.LegacyDec) []byte {
+	return append(GetOrdersByPriceIndexKeyPrefix(pairId, dir), SortablePriceBytes(price)...)
+}
+
+// GetOrdersByFieldIndexKey returns the index key to iterate orders within the
+// pair by the value of the field.
+func GetOrdersByFieldIndexKey(pairId uint64, field OrderIndexField, value byte, orderId uint64) []byte {
+	return append(GetOrdersByFieldIndexKeyPrefix(pairId, field, value), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetOrdersByFieldIndexKeyPrefix returns the index key prefix to iterate
+// orders with the value of the field within the pair.
+func GetOrdersByFieldIndexKeyPrefix(pairId uint64, field OrderIndexField, value byte) []byte {
+	return append(append(OrdersByFieldIndexKeyPrefix, sdk.Uint64ToBigEndian(pairId)...), byte(field), value)
+}
+
+// GetOrdersByOrdererFieldIndexKey returns the index key to iterate orders by
+// an orderer by the value of the field.
+func GetOrdersByOrdererFieldIndexKey(orderer sdk.AccAddress, field OrderIndexField, value byte, pairId, orderId uint64) []byte {
+	return append(GetOrdersByOrdererFieldIndexKeyPrefixByPair(orderer, field, value, pairId), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetOrdersByOrdererFieldIndexKeyPrefix returns the index key prefix to
+// iterate orders with the value of the field by an orderer.
+func GetOrdersByOrdererFieldIndexKeyPrefix(orderer sdk.AccAddress, field OrderIndexField, value byte) []byte {
+	return append(append(OrdersByOrdererFieldIndexKeyPrefix, address.MustLengthPrefix(orderer)...), byte(field), value)
+}
+
+// GetOrdersByOrdererFieldIndexKeyPrefixByPair returns the index key prefix to
+// iterate orders with the value of the field by an orderer in a pair.
+func GetOrdersByOrdererFieldIndexKeyPrefixByPair(orderer sdk.AccAddress, field OrderIndexField, value byte, pairId uint64) []byte {
+	return append(GetOrdersByOrdererFieldIndexKeyPrefix(orderer, field, value), sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetDepositRequestDeletionQueueKey returns the key to queue a deposit request
+// to be deleted at the given height.
+func GetDepositRequestDeletionQueueKey(height int64, poolId, reqId uint64) []byte {
+	return append(append(GetDepositRequestDeletionQueueKeyPrefix(height), sdk.Uint64ToBigEndian(poolId)...), sdk.Uint64ToBigEndian(reqId)...)
+}
+
+// GetDepositRequestDeletionQueueKeyPrefix returns the key prefix to iterate
+// deposit requests to be deleted at the given height.
+func GetDepositRequestDeletionQueueKeyPrefix(height int64) []byte {
+	return append(DepositRequestDeletionQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetWithdrawRequestDeletionQueueKey returns the key to queue a withdraw request
+// to be deleted at the given height.
+func GetWithdrawRequestDeletionQueueKey(height int64, poolId, reqId uint64) []byte {
+	return append(append(GetWithdrawRequestDeletionQueueKeyPrefix(height), sdk.Uint64ToBigEndian(poolId)...), sdk.Uint64ToBigEndian(reqId)...)
+}
+
+// GetWithdrawRequestDeletionQueueKeyPrefix returns the key prefix to iterate
+// withdraw requests to be deleted at the given height.
+func GetWithdrawRequestDeletionQueueKeyPrefix(height int64) []byte {
+	return append(WithdrawRequestDeletionQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetOrderDeletionQueueKey returns the key to queue an order to be deleted
+// at the given height.
+func GetOrderDeletionQueueKey(height int64, pairId, orderId uint64) []byte {
+	return append(append(GetOrderDeletionQueueKeyPrefix(height), sdk.Uint64ToBigEndian(pairId)...), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetOrderDeletionQueueKeyPrefix returns the key prefix to iterate orders
+// to be deleted at the given height.
+func GetOrderDeletionQueueKeyPrefix(height int64) []byte {
+	return append(OrderDeletionQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ParsePairKey parses a pair id from the pair key.
+func ParsePairKey(key []byte) (pairId uint64) {
+	if !bytes.HasPrefix(key, PairKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	return sdk.BigEndianToUint64(key[1:])
+}
+
+// ParsePoolKey parses a pool id from the pool key.
+func ParsePoolKey(key []byte) (poolId uint64) {
+	if !bytes.HasPrefix(key, PoolKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	return sdk.BigEndianToUint64(key[1:])
+}
+
+// ParseDepositRequestKey parses a deposit request key.
+func ParseDepositRequestKey(key []byte) (poolId, reqId uint64) {
+	if !bytes.HasPrefix(key, DepositRequestKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	poolId = sdk.BigEndianToUint64(key[1:9])
+	reqId = sdk.BigEndianToUint64(key[9:])
+	return
+}
+
+// ParseWithdrawRequestKey parses a withdraw request key.
+func ParseWithdrawRequestKey(key []byte) (poolId, reqId uint64) {
+	if !bytes.HasPrefix(key, WithdrawRequestKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	poolId = sdk.BigEndianToUint64(key[1:9])
+	reqId = sdk.BigEndianToUint64(key[9:])
+	return
+}
+
+// ParseOrderKey parses an order key.
+func ParseOrderKey(key []byte) (pairId, orderId uint64) {
+	if !bytes.HasPrefix(key, OrderKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	pairId = sdk.BigEndianToUint64(key[1:9])
+	orderId = sdk.BigEndianToUint64(key[9:])
+	return
+}
+
+// ParseMMOrderIndexKey parses an MM order index key.
+func ParseMMOrderIndexKey(key []byte) (orderer sdk.AccAddress, pairId uint64) {
+	if !bytes.HasPrefix(key, MMOrderIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	addrLen := key[1]
+	orderer = key[2 : 2+addrLen]
+	pairId = sdk.BigEndianToUint64(key[2+addrLen:])
+	return
 }
 
 // ParsePairsByDenomsIndexKey parses a pair by denom index key.
@@ -216,6 +447,94 @@ func ParseOrderIndexKey(key []byte) (orderer sdk.AccAddress, pairId, orderId uin
 	return
 }
 
+// ParseOrderExpiryIndexKey parses an order expiry index key.
+func ParseOrderExpiryIndexKey(key []byte) (expireAt time.Time, pairId, orderId uint64) {
+	if !bytes.HasPrefix(key, OrderExpiryIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	timeLen := len(key) - 1 - 16
+	expireAt, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(err)
+	}
+	pairId = sdk.BigEndianToUint64(key[1+timeLen : 1+timeLen+8])
+	orderId = sdk.BigEndianToUint64(key[1+timeLen+8:])
+	return
+}
+
+// ParseOrdersByPriceIndexKey parses an orders by price index key.
+func ParseOrdersByPriceIndexKey(key []byte) (pairId uint64, dir OrderDirection, price math.LegacyDec, batchId, orderId uint64) {
+	if !bytes.HasPrefix(key, OrdersByPriceIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	pairId = sdk.BigEndianToUint64(key[1:9])
+	dir = OrderDirection(key[9])
+	priceLen := key[10]
+	price = math.LegacyNewDecFromBigIntWithPrec(new(big.Int).SetBytes(key[11:11+priceLen]), math.LegacyPrecision)
+	batchId = sdk.BigEndianToUint64(key[11+priceLen : 11+priceLen+8])
+	orderId = sdk.BigEndianToUint64(key[11+priceLen+8:])
+	return
+}
+
+// ParseOrdersByFieldIndexKey parses an orders by field index key.
+func ParseOrdersByFieldIndexKey(key []byte) (pairId uint64, field OrderIndexField, value byte, orderId uint64) {
+	if !bytes.HasPrefix(key, OrdersByFieldIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	pairId = sdk.BigEndianToUint64(key[1:9])
+	field = OrderIndexField(key[9])
+	value = key[10]
+	orderId = sdk.BigEndianToUint64(key[11:])
+	return
+}
+
+// ParseOrdersByOrdererFieldIndexKey parses an orders by orderer field index
+// key.
+func ParseOrdersByOrdererFieldIndexKey(key []byte) (orderer sdk.AccAddress, field OrderIndexField, value byte, pairId, orderId uint64) {
+	if !bytes.HasPrefix(key, OrdersByOrdererFieldIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	addrLen := key[1]
+	orderer = key[2 : 2+addrLen]
+	field = OrderIndexField(key[2+addrLen])
+	value = key[3+addrLen]
+	pairId = sdk.BigEndianToUint64(key[4+addrLen : 4+addrLen+8])
+	orderId = sdk.BigEndianToUint64(key[4+addrLen+8:])
+	return
+}
+
+// ParseDeletionQueueKey parses a deletion queue key of deposit requests,
+// withdraw requests or orders.
+// id1 is the pool id for requests and the pair id for orders, and id2 is
+// the id of the request or the order.
+func ParseDeletionQueueKey(key []byte) (height int64, id1, id2 uint64) {
+	if !bytes.HasPrefix(key, DepositRequestDeletionQueueKeyPrefix) &&
+		!bytes.HasPrefix(key, WithdrawRequestDeletionQueueKeyPrefix) &&
+		!bytes.HasPrefix(key, OrderDeletionQueueKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	height = int64(sdk.BigEndianToUint64(key[1:9]))
+	id1 = sdk.BigEndianToUint64(key[9:17])
+	id2 = sdk.BigEndianToUint64(key[17:])
+	return
+}
+
+// SortablePriceBytes returns length-prefixed bytes representation of
+// a positive price, which preserves the order of prices when compared
+// lexicographically.
+func SortablePriceBytes(price math.LegacyDec) []byte {
+	if !price.IsPositive() {
+		panic("price must be positive")
+	}
+	bz := price.BigInt().Bytes()
+	return append([]byte{byte(len(bz))}, bz...)
+}
+
 // LengthPrefixString returns length-prefixed bytes representation
 // of a string.
 func LengthPrefixString(s string) []byte {
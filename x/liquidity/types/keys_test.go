@@ -3,7 +3,9 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,6 +26,7 @@ func (s *keysTestSuite) TestGetPairKey() {
 	s.Require().Equal([]byte{0xa5, 0, 0, 0, 0, 0, 0, 0, 0}, types.GetPairKey(0))
 	s.Require().Equal([]byte{0xa5, 0, 0, 0, 0, 0, 0, 0, 0x9}, types.GetPairKey(9))
 	s.Require().Equal([]byte{0xa5, 0, 0, 0, 0, 0, 0, 0, 0xa}, types.GetPairKey(10))
+	s.Require().Equal(uint64(10), types.ParsePairKey(types.GetPairKey(10)))
 }
 
 func (s *keysTestSuite) TestGetPairIndexKey() {
@@ -78,6 +81,7 @@ func (s *keysTestSuite) TestGetPoolKey() {
 	s.Require().Equal([]byte{0xab, 0, 0, 0, 0, 0, 0, 0, 0x1}, types.GetPoolKey(1))
 	s.Require().Equal([]byte{0xab, 0, 0, 0, 0, 0, 0, 0, 0x5}, types.GetPoolKey(5))
 	s.Require().Equal([]byte{0xab, 0, 0, 0, 0, 0, 0, 0, 0xa}, types.GetPoolKey(10))
+	s.Require().Equal(uint64(10), types.ParsePoolKey(types.GetPoolKey(10)))
 }
 
 func (s *keysTestSuite) TestGetPoolByReserveAddressIndexKey() {
@@ -134,12 +138,15 @@ func (s *keysTestSuite) TestGetDepositRequestKey() {
 		0, 0, 0, 0, 0, 0x1}, types.GetDepositRequestKey(1, 1))
 	s.Require().Equal([]byte{0xb0, 0, 0, 0, 0, 0, 0, 0x3, 0xe8, 0,
 		0, 0, 0, 0, 0, 0x3, 0xe9}, types.GetDepositRequestKey(1000, 1001))
+	poolId, reqId := types.ParseDepositRequestKey(types.GetDepositRequestKey(1000, 1001))
+	s.Require().Equal(uint64(1000), poolId)
+	s.Require().Equal(uint64(1001), reqId)
 }
 
 func (s *keysTestSuite) TestDepositRequestIndexKey() {
 	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
 	key := types.GetDepositRequestIndexKey(depositor, 1, 2)
-	s.Require().Equal([]byte{0xb4, 0x14, 0x9a, 0x69, 0x97, 0x1f, 0x1d, 0xb2, 0xe1, 0xd8, 0x77,
+	s.Require().Equal([]byte{0xbc, 0x14, 0x9a, 0x69, 0x97, 0x1f, 0x1d, 0xb2, 0xe1, 0xd8, 0x77,
 		0x73, 0x6f, 0x7d, 0x36, 0x96, 0x90, 0xa3, 0xbf, 0x57, 0xcf, 0x22, 0, 0, 0, 0,
 		0, 0, 0, 0x1, 0, 0, 0, 0, 0, 0, 0, 0x2}, key)
 	s.Require().True(bytes.HasPrefix(key, types.GetDepositRequestIndexKeyPrefix(depositor)))
@@ -154,12 +161,15 @@ func (s *keysTestSuite) TestGetWithdrawRequestKey() {
 		0, 0, 0, 0, 0, 0x1}, types.GetWithdrawRequestKey(1, 1))
 	s.Require().Equal([]byte{0xb1, 0, 0, 0, 0, 0, 0, 0x3, 0xe8, 0,
 		0, 0, 0, 0, 0, 0x3, 0xe9}, types.GetWithdrawRequestKey(1000, 1001))
+	poolId, reqId := types.ParseWithdrawRequestKey(types.GetWithdrawRequestKey(1000, 1001))
+	s.Require().Equal(uint64(1000), poolId)
+	s.Require().Equal(uint64(1001), reqId)
 }
 
 func (s *keysTestSuite) TestWithdrawRequestIndexKey() {
 	withdrawer := sdk.AccAddress(crypto.AddressHash([]byte("withdrawer")))
 	key := types.GetWithdrawRequestIndexKey(withdrawer, 1, 2)
-	s.Require().Equal([]byte{0xb5, 0x14, 0x19, 0xcd, 0x70, 0x1f, 0x44, 0xf1, 0xed, 0xe, 0x3,
+	s.Require().Equal([]byte{0xbd, 0x14, 0x19, 0xcd, 0x70, 0x1f, 0x44, 0xf1, 0xed, 0xe, 0x3,
 		0xa7, 0xf3, 0xf8, 0x7c, 0xff, 0x84, 0x79, 0x58, 0xc6, 0x56, 0xc2, 0, 0, 0, 0,
 		0, 0, 0, 0x1, 0, 0, 0, 0, 0, 0, 0, 0x2}, key)
 	s.Require().True(bytes.HasPrefix(key, types.GetWithdrawRequestIndexKeyPrefix(withdrawer)))
@@ -174,6 +184,9 @@ func (s *keysTestSuite) TestGetOrderKey() {
 		0, 0, 0, 0, 0, 0x1}, types.GetOrderKey(1, 1))
 	s.Require().Equal([]byte{0xb2, 0, 0, 0, 0, 0, 0, 0x3, 0xe8, 0,
 		0, 0, 0, 0, 0, 0x3, 0xe9}, types.GetOrderKey(1000, 1001))
+	pairId, orderId := types.ParseOrderKey(types.GetOrderKey(1000, 1001))
+	s.Require().Equal(uint64(1000), pairId)
+	s.Require().Equal(uint64(1001), orderId)
 }
 
 func (s *keysTestSuite) TestGetOrdersByPairKeyPrefix() {
@@ -188,6 +201,8 @@ func (s *keysTestSuite) TestOrderIndexKey() {
 		0x5c, 0xbc, 0x50, 0xf2, 0x85, 0xf7, 0x7d, 0xff, 0x52, 0x9f, 0x25, 0, 0, 0, 0,
 		0, 0, 0, 0x1, 0, 0, 0, 0, 0, 0, 0, 0x1}, key)
 	s.Require().True(bytes.HasPrefix(key, types.GetOrderIndexKeyPrefix(orderer)))
+	s.Require().True(bytes.HasPrefix(key, types.GetOrderIndexKeyPrefixByPair(orderer, 1)))
+	s.Require().False(bytes.HasPrefix(key, types.GetOrderIndexKeyPrefixByPair(orderer, 2)))
 	orderer2, pairId, orderId := types.ParseOrderIndexKey(key)
 	s.Require().Equal(orderer, orderer2)
 	s.Require().Equal(uint64(1), pairId)
@@ -200,4 +215,112 @@ func (s *keysTestSuite) TestMMOrderIndexKey() {
 	s.Require().Equal([]byte{0xb6, 0x14, 0x54, 0x7e, 0xfe, 0x47, 0x8f, 0xc9, 0xf9, 0x52, 0xb2,
 		0x5c, 0xbc, 0x50, 0xf2, 0x85, 0xf7, 0x7d, 0xff, 0x52, 0x9f, 0x25, 0x0, 0x0, 0x0, 0x0,
 		0x0, 0x0, 0x0, 0x1}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetMMOrderIndexKeyPrefix(orderer)))
+	orderer2, pairId := types.ParseMMOrderIndexKey(key)
+	s.Require().Equal(orderer, orderer2)
+	s.Require().Equal(uint64(1), pairId)
+}
+
+func (s *keysTestSuite) TestOrderExpiryIndexKey() {
+	expireAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	key := types.GetOrderExpiryIndexKey(expireAt, 1, 2)
+	s.Require().Equal(append(append([]byte{0xb7}, []byte("2022-01-01T00:00:00.000000000")...),
+		0, 0, 0, 0, 0, 0, 0, 0x1, 0, 0, 0, 0, 0, 0, 0, 0x2), key)
+	s.Require().True(bytes.HasPrefix(key, types.GetOrderExpiryIndexKeyPrefix(expireAt)))
+	expireAt2, pairId, orderId := types.ParseOrderExpiryIndexKey(key)
+	s.Require().True(expireAt.Equal(expireAt2))
+	s.Require().Equal(uint64(1), pairId)
+	s.Require().Equal(uint64(2), orderId)
+
+	// Keys are sorted by their expiration time first.
+	s.Require().Equal(-1, bytes.Compare(
+		types.GetOrderExpiryIndexKey(expireAt, 1000, 1000),
+		types.GetOrderExpiryIndexKey(expireAt.Add(time.Second), 1, 1)))
+}
+
+func (s *keysTestSuite) TestDeletionQueueKey() {
+	for _, tc := range []struct {
+		key    []byte
+		prefix []byte
+	}{
+		{types.GetDepositRequestDeletionQueueKey(10, 1, 2), types.GetDepositRequestDeletionQueueKeyPrefix(10)},
+		{types.GetWithdrawRequestDeletionQueueKey(10, 1, 2), types.GetWithdrawRequestDeletionQueueKeyPrefix(10)},
+		{types.GetOrderDeletionQueueKey(10, 1, 2), types.GetOrderDeletionQueueKeyPrefix(10)},
+	} {
+		s.Require().Equal([]byte{0, 0, 0, 0, 0, 0, 0, 0xa, 0, 0, 0, 0, 0, 0, 0, 0x1,
+			0, 0, 0, 0, 0, 0, 0, 0x2}, tc.key[1:])
+		s.Require().True(bytes.HasPrefix(tc.key, tc.prefix))
+		height, id1, id2 := types.ParseDeletionQueueKey(tc.key)
+		s.Require().Equal(int64(10), height)
+		s.Require().Equal(uint64(1), id1)
+		s.Require().Equal(uint64(2), id2)
+	}
+	s.Require().Equal(byte(0xb9), types.GetDepositRequestDeletionQueueKey(10, 1, 2)[0])
+	s.Require().Equal(byte(0xba), types.GetWithdrawRequestDeletionQueueKey(10, 1, 2)[0])
+	s.Require().Equal(byte(0xb8), types.GetOrderDeletionQueueKey(10, 1, 2)[0])
+}
+
+func (s *keysTestSuite) TestOrdersByPriceIndexKey() {
+	price := math.LegacyMustNewDecFromStr("1.5")
+	key := types.GetOrdersByPriceIndexKey(1, types.OrderDirectionSell, price, 2, 3)
+	s.Require().Equal([]byte{0xbb, 0, 0, 0, 0, 0, 0, 0, 0x1, 0x2, 0x8, 0x14, 0xd1, 0x12, 0x0d,
+		0x7b, 0x16, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x2, 0, 0, 0, 0, 0, 0, 0, 0x3}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetOrdersByPriceIndexKeyPrefix(1, types.OrderDirectionSell)))
+	s.Require().True(bytes.HasPrefix(key, types.GetOrdersByPriceIndexKeyPrefixWithPrice(1, types.OrderDirectionSell, price)))
+	pairId, dir, price2, batchId, orderId := types.ParseOrdersByPriceIndexKey(key)
+	s.Require().Equal(uint64(1), pairId)
+	s.Require().Equal(types.OrderDirectionSell, dir)
+	s.Require().True(price.Equal(price2))
+	s.Require().Equal(uint64(2), batchId)
+	s.Require().Equal(uint64(3), orderId)
+}
+
+func (s *keysTestSuite) TestOrdersByFieldIndexKey() {
+	key := types.GetOrdersByFieldIndexKey(1, types.OrderIndexFieldStatus, byte(types.OrderStatusNotMatched), 2)
+	s.Require().Equal([]byte{0xbf, 0, 0, 0, 0, 0, 0, 0, 0x1, 0x1, 0x2, 0, 0, 0, 0, 0, 0, 0, 0x2}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetOrdersByFieldIndexKeyPrefix(
+		1, types.OrderIndexFieldStatus, byte(types.OrderStatusNotMatched))))
+	s.Require().False(bytes.HasPrefix(key, types.GetOrdersByFieldIndexKeyPrefix(
+		1, types.OrderIndexFieldDirection, byte(types.OrderDirectionSell))))
+	pairId, field, value, orderId := types.ParseOrdersByFieldIndexKey(key)
+	s.Require().Equal(uint64(1), pairId)
+	s.Require().Equal(types.OrderIndexFieldStatus, field)
+	s.Require().Equal(byte(types.OrderStatusNotMatched), value)
+	s.Require().Equal(uint64(2), orderId)
+}
+
+func (s *keysTestSuite) TestOrdersByOrdererFieldIndexKey() {
+	orderer := sdk.AccAddress(crypto.AddressHash([]byte("orderer")))
+	key := types.GetOrdersByOrdererFieldIndexKey(orderer, types.OrderIndexFieldType, byte(types.OrderTypeMM), 1, 2)
+	s.Require().Equal([]byte{0xc0, 0x14, 0x54, 0x7e, 0xfe, 0x47, 0x8f, 0xc9, 0xf9, 0x52, 0xb2,
+		0x5c, 0xbc, 0x50, 0xf2, 0x85, 0xf7, 0x7d, 0xff, 0x52, 0x9f, 0x25, 0x3, 0x3, 0, 0, 0, 0,
+		0, 0, 0, 0x1, 0, 0, 0, 0, 0, 0, 0, 0x2}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetOrdersByOrdererFieldIndexKeyPrefix(
+		orderer, types.OrderIndexFieldType, byte(types.OrderTypeMM))))
+	s.Require().True(bytes.HasPrefix(key, types.GetOrdersByOrdererFieldIndexKeyPrefixByPair(
+		orderer, types.OrderIndexFieldType, byte(types.OrderTypeMM), 1)))
+	s.Require().False(bytes.HasPrefix(key, types.GetOrdersByOrdererFieldIndexKeyPrefixByPair(
+		orderer, types.OrderIndexFieldType, byte(types.OrderTypeMM), 2)))
+	orderer2, field, value, pairId, orderId := types.ParseOrdersByOrdererFieldIndexKey(key)
+	s.Require().Equal(orderer, orderer2)
+	s.Require().Equal(types.OrderIndexFieldType, field)
+	s.Require().Equal(byte(types.OrderTypeMM), value)
+	s.Require().Equal(uint64(1), pairId)
+	s.Require().Equal(uint64(2), orderId)
+}
+
+func (s *keysTestSuite) TestSortablePriceBytes() {
+	prices := []string{"0.000000000000000001", "0.0001", "0.9999", "1", "1.0001", "99", "100", "1000000000000000000000000"}
+	for i := 1; i < len(prices); i++ {
+		s.Require().Equal(-1, bytes.Compare(
+			types.SortablePriceBytes(math.LegacyMustNewDecFromStr(prices[i-1])),
+			types.SortablePriceBytes(math.LegacyMustNewDecFromStr(prices[i]))))
+	}
+}
+
+func (s *keysTestSuite) TestGetICARequestKey() {
+	s.Require().Equal([]byte{0xbe, 0x9, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2d, 0x30, 0, 0, 0, 0, 0, 0, 0, 0x1},
+		types.GetICARequestKey("channel-0", 1))
+	// Keys of the channels whose ids are prefixes of others don't collide.
+	s.Require().False(bytes.HasPrefix(types.GetICARequestKey("channel-10", 1), types.GetICARequestKey("channel-1", 1)[:11]))
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	mathsdk "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -16,7 +17,6 @@ import (
 	math "math"
 	math_bits "math/bits"
 	time "time"
-	mathsdk "cosmossdk.io/math" 
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -215,6 +215,46 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c9be4f53a63dce2f, []int{4}
 }
 
+// ICARequestStatus enumerates ICA request statuses.
+type ICARequestStatus int32
+
+const (
+	// ICA_REQUEST_STATUS_UNSPECIFIED specifies unknown ICA request status
+	ICARequestStatusUnspecified ICARequestStatus = 0
+	// ICA_REQUEST_STATUS_PENDING indicates the request hasn't been acknowledged yet
+	ICARequestStatusPending ICARequestStatus = 1
+	// ICA_REQUEST_STATUS_SUCCEEDED indicates the request has been executed on the host chain
+	ICARequestStatusSucceeded ICARequestStatus = 2
+	// ICA_REQUEST_STATUS_FAILED indicates the request has failed on the host chain
+	ICARequestStatusFailed ICARequestStatus = 3
+	// ICA_REQUEST_STATUS_TIMED_OUT indicates the packet of the request has timed out
+	ICARequestStatusTimedOut ICARequestStatus = 4
+)
+
+var ICARequestStatus_name = map[int32]string{
+	0: "ICA_REQUEST_STATUS_UNSPECIFIED",
+	1: "ICA_REQUEST_STATUS_PENDING",
+	2: "ICA_REQUEST_STATUS_SUCCEEDED",
+	3: "ICA_REQUEST_STATUS_FAILED",
+	4: "ICA_REQUEST_STATUS_TIMED_OUT",
+}
+
+var ICARequestStatus_value = map[string]int32{
+	"ICA_REQUEST_STATUS_UNSPECIFIED": 0,
+	"ICA_REQUEST_STATUS_PENDING":     1,
+	"ICA_REQUEST_STATUS_SUCCEEDED":   2,
+	"ICA_REQUEST_STATUS_FAILED":      3,
+	"ICA_REQUEST_STATUS_TIMED_OUT":   4,
+}
+
+func (x ICARequestStatus) String() string {
+	return proto.EnumName(ICARequestStatus_name, int32(x))
+}
+
+func (ICARequestStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{5}
+}
+
 // Params defines the parameters for the liquidity module.
 type Params struct {
 	BatchSize                    uint32                                   `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
@@ -225,15 +265,24 @@ type Params struct {
 	PairCreationFee              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pair_creation_fee,json=pairCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pair_creation_fee"`
 	PoolCreationFee              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee"`
 	MinInitialDepositAmount      github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,8,opt,name=min_initial_deposit_amount,json=minInitialDepositAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_initial_deposit_amount"`
-	MaxPriceLimitRatio           mathsdk.LegacyDec   `protobuf:"bytes,9,opt,name=max_price_limit_ratio,json=maxPriceLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/math.LegacyDec" json:"max_price_limit_ratio"`
+	MaxPriceLimitRatio           mathsdk.LegacyDec                        `protobuf:"bytes,9,opt,name=max_price_limit_ratio,json=maxPriceLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/math.LegacyDec" json:"max_price_limit_ratio"`
 	MaxNumMarketMakingOrderTicks uint32                                   `protobuf:"varint,10,opt,name=max_num_market_making_order_ticks,json=maxNumMarketMakingOrderTicks,proto3" json:"max_num_market_making_order_ticks,omitempty"`
 	MaxOrderLifespan             time.Duration                            `protobuf:"bytes,11,opt,name=max_order_lifespan,json=maxOrderLifespan,proto3,stdduration" json:"max_order_lifespan"`
-	SwapFeeRate                  mathsdk.LegacyDec   `protobuf:"bytes,12,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/math.LegacyDec" json:"swap_fee_rate"`
-	WithdrawFeeRate              mathsdk.LegacyDec   `protobuf:"bytes,13,opt,name=withdraw_fee_rate,json=withdrawFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/math.LegacyDec" json:"withdraw_fee_rate"`
+	SwapFeeRate                  mathsdk.LegacyDec                        `protobuf:"bytes,12,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/math.LegacyDec" json:"swap_fee_rate"`
+	WithdrawFeeRate              mathsdk.LegacyDec                        `protobuf:"bytes,13,opt,name=withdraw_fee_rate,json=withdrawFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/math.LegacyDec" json:"withdraw_fee_rate"`
 	DepositExtraGas              github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,14,opt,name=deposit_extra_gas,json=depositExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"deposit_extra_gas"`
 	WithdrawExtraGas             github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,15,opt,name=withdraw_extra_gas,json=withdrawExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"withdraw_extra_gas"`
 	OrderExtraGas                github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,16,opt,name=order_extra_gas,json=orderExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"order_extra_gas"`
 	MaxNumActivePoolsPerPair     uint32                                   `protobuf:"varint,17,opt,name=max_num_active_pools_per_pair,json=maxNumActivePoolsPerPair,proto3" json:"max_num_active_pools_per_pair,omitempty"`
+	// finished_record_retention_blocks is the number of blocks to keep finished
+	// orders and requests in the store before deleting them.
+	FinishedRecordRetentionBlocks uint32 `protobuf:"varint,18,opt,name=finished_record_retention_blocks,json=finishedRecordRetentionBlocks,proto3" json:"finished_record_retention_blocks,omitempty"`
+	// fee_denoms are the denoms other than the staking coin which transaction
+	// fees can be paid in.
+	FeeDenoms []string `protobuf:"bytes,19,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms,omitempty"`
+	// min_fee_pool_reserve is the minimum staking coin reserve of a pool to
+	// value fees paid in non-native coins by the pool's price.
+	MinFeePoolReserve github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,20,opt,name=min_fee_pool_reserve,json=minFeePoolReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee_pool_reserve"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -271,13 +320,13 @@ var xxx_messageInfo_Params proto.InternalMessageInfo
 
 // Pair defines a coin pair.
 type Pair struct {
-	Id             uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BaseCoinDenom  string                                  `protobuf:"bytes,2,opt,name=base_coin_denom,json=baseCoinDenom,proto3" json:"base_coin_denom,omitempty"`
-	QuoteCoinDenom string                                  `protobuf:"bytes,3,opt,name=quote_coin_denom,json=quoteCoinDenom,proto3" json:"quote_coin_denom,omitempty"`
-	EscrowAddress  string                                  `protobuf:"bytes,4,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
-	LastOrderId    uint64                                  `protobuf:"varint,5,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"`
+	Id             uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BaseCoinDenom  string             `protobuf:"bytes,2,opt,name=base_coin_denom,json=baseCoinDenom,proto3" json:"base_coin_denom,omitempty"`
+	QuoteCoinDenom string             `protobuf:"bytes,3,opt,name=quote_coin_denom,json=quoteCoinDenom,proto3" json:"quote_coin_denom,omitempty"`
+	EscrowAddress  string             `protobuf:"bytes,4,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
+	LastOrderId    uint64             `protobuf:"varint,5,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"`
 	LastPrice      *mathsdk.LegacyDec `protobuf:"bytes,6,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/math.LegacyDec" json:"last_price,omitempty"`
-	CurrentBatchId uint64                                  `protobuf:"varint,7,opt,name=current_batch_id,json=currentBatchId,proto3" json:"current_batch_id,omitempty"`
+	CurrentBatchId uint64             `protobuf:"varint,7,opt,name=current_batch_id,json=currentBatchId,proto3" json:"current_batch_id,omitempty"`
 }
 
 func (m *Pair) Reset()         { *m = Pair{} }
@@ -316,17 +365,17 @@ var xxx_messageInfo_Pair proto.InternalMessageInfo
 // Pool defines generic liquidity pool object which can be either a basic pool or a
 // ranged pool.
 type Pool struct {
-	Type                  PoolType                                `protobuf:"varint,1,opt,name=type,proto3,enum=crescent.liquidity.v1beta1.PoolType" json:"type,omitempty"`
-	Id                    uint64                                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	PairId                uint64                                  `protobuf:"varint,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
-	Creator               string                                  `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
-	ReserveAddress        string                                  `protobuf:"bytes,5,opt,name=reserve_address,json=reserveAddress,proto3" json:"reserve_address,omitempty"`
-	PoolCoinDenom         string                                  `protobuf:"bytes,6,opt,name=pool_coin_denom,json=poolCoinDenom,proto3" json:"pool_coin_denom,omitempty"`
+	Type                  PoolType           `protobuf:"varint,1,opt,name=type,proto3,enum=crescent.liquidity.v1beta1.PoolType" json:"type,omitempty"`
+	Id                    uint64             `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	PairId                uint64             `protobuf:"varint,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Creator               string             `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	ReserveAddress        string             `protobuf:"bytes,5,opt,name=reserve_address,json=reserveAddress,proto3" json:"reserve_address,omitempty"`
+	PoolCoinDenom         string             `protobuf:"bytes,6,opt,name=pool_coin_denom,json=poolCoinDenom,proto3" json:"pool_coin_denom,omitempty"`
 	MinPrice              *mathsdk.LegacyDec `protobuf:"bytes,7,opt,name=min_price,json=minPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/math.LegacyDec" json:"min_price,omitempty"`
 	MaxPrice              *mathsdk.LegacyDec `protobuf:"bytes,8,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/math.LegacyDec" json:"max_price,omitempty"`
-	LastDepositRequestId  uint64                                  `protobuf:"varint,9,opt,name=last_deposit_request_id,json=lastDepositRequestId,proto3" json:"last_deposit_request_id,omitempty"`
-	LastWithdrawRequestId uint64                                  `protobuf:"varint,10,opt,name=last_withdraw_request_id,json=lastWithdrawRequestId,proto3" json:"last_withdraw_request_id,omitempty"`
-	Disabled              bool                                    `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	LastDepositRequestId  uint64             `protobuf:"varint,9,opt,name=last_deposit_request_id,json=lastDepositRequestId,proto3" json:"last_deposit_request_id,omitempty"`
+	LastWithdrawRequestId uint64             `protobuf:"varint,10,opt,name=last_withdraw_request_id,json=lastWithdrawRequestId,proto3" json:"last_withdraw_request_id,omitempty"`
+	Disabled              bool               `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -483,13 +532,16 @@ type Order struct {
 	// received_coin specifies the received coin after the swap
 	ReceivedCoin types.Coin `protobuf:"bytes,9,opt,name=received_coin,json=receivedCoin,proto3" json:"received_coin"`
 	// price specifies the price that an orderer is willing to swap
-	Price      mathsdk.LegacyDec `protobuf:"bytes,10,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/math.LegacyDec" json:"price"`
+	Price      mathsdk.LegacyDec                      `protobuf:"bytes,10,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/math.LegacyDec" json:"price"`
 	Amount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 	OpenAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=open_amount,json=openAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"open_amount"`
 	// batch_id specifies the pair's batch id when the request is stored
 	BatchId  uint64      `protobuf:"varint,13,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
 	ExpireAt time.Time   `protobuf:"bytes,14,opt,name=expire_at,json=expireAt,proto3,stdtime" json:"expire_at"`
 	Status   OrderStatus `protobuf:"varint,15,opt,name=status,proto3,enum=crescent.liquidity.v1beta1.OrderStatus" json:"status,omitempty"`
+	// forward specifies where to send the received coin over IBC when the order
+	// is finished, if set
+	Forward *IBCForward `protobuf:"bytes,16,opt,name=forward,proto3" json:"forward,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -525,6 +577,109 @@ func (m *Order) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Order proto.InternalMessageInfo
 
+// IBCForward defines an ICS-20 transfer destination of the coin received by
+// an order.
+type IBCForward struct {
+	// channel_id specifies the source channel of the transfer port
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// receiver specifies the receiver address on the destination chain
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout specifies the timeout of the transfer, which is relative to the
+	// block time when the order is finished
+	Timeout time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout"`
+}
+
+func (m *IBCForward) Reset()         { *m = IBCForward{} }
+func (m *IBCForward) String() string { return proto.CompactTextString(m) }
+func (*IBCForward) ProtoMessage()    {}
+func (*IBCForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{6}
+}
+func (m *IBCForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCForward.Merge(m, src)
+}
+func (m *IBCForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCForward proto.InternalMessageInfo
+
+// ICARequest defines a request sent to the interchain account of an owner
+// through the liquidity module, and its result on the host chain.
+type ICARequest struct {
+	// channel_id specifies the channel of the interchain account
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence specifies the sequence of the packet sent
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// owner specifies the bech32-encoded address that owns the interchain account
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection_id specifies the connection to the host chain
+	ConnectionId string `protobuf:"bytes,4,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// msg_type_url specifies the type url of the message executed on the host
+	// chain
+	MsgTypeUrl string `protobuf:"bytes,5,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// pair_id specifies the pair id on the host chain
+	PairId uint64 `protobuf:"varint,6,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// status specifies the status of the request
+	Status ICARequestStatus `protobuf:"varint,7,opt,name=status,proto3,enum=crescent.liquidity.v1beta1.ICARequestStatus" json:"status,omitempty"`
+	// order_ids specifies the ids of the orders made or canceled on the host
+	// chain
+	OrderIds []uint64 `protobuf:"varint,8,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	// error specifies the error of the request when it has failed
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ICARequest) Reset()         { *m = ICARequest{} }
+func (m *ICARequest) String() string { return proto.CompactTextString(m) }
+func (*ICARequest) ProtoMessage()    {}
+func (*ICARequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{7}
+}
+func (m *ICARequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICARequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICARequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICARequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICARequest.Merge(m, src)
+}
+func (m *ICARequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICARequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICARequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICARequest proto.InternalMessageInfo
+
 // MMOrderIndex defines an index type to quickly find market making orders
 // from an orderer.
 type MMOrderIndex struct {
@@ -537,7 +692,7 @@ func (m *MMOrderIndex) Reset()         { *m = MMOrderIndex{} }
 func (m *MMOrderIndex) String() string { return proto.CompactTextString(m) }
 func (*MMOrderIndex) ProtoMessage()    {}
 func (*MMOrderIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{6}
+	return fileDescriptor_c9be4f53a63dce2f, []int{8}
 }
 func (m *MMOrderIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -572,12 +727,15 @@ func init() {
 	proto.RegisterEnum("crescent.liquidity.v1beta1.OrderDirection", OrderDirection_name, OrderDirection_value)
 	proto.RegisterEnum("crescent.liquidity.v1beta1.RequestStatus", RequestStatus_name, RequestStatus_value)
 	proto.RegisterEnum("crescent.liquidity.v1beta1.OrderStatus", OrderStatus_name, OrderStatus_value)
+	proto.RegisterEnum("crescent.liquidity.v1beta1.ICARequestStatus", ICARequestStatus_name, ICARequestStatus_value)
 	proto.RegisterType((*Params)(nil), "crescent.liquidity.v1beta1.Params")
 	proto.RegisterType((*Pair)(nil), "crescent.liquidity.v1beta1.Pair")
 	proto.RegisterType((*Pool)(nil), "crescent.liquidity.v1beta1.Pool")
 	proto.RegisterType((*DepositRequest)(nil), "crescent.liquidity.v1beta1.DepositRequest")
 	proto.RegisterType((*WithdrawRequest)(nil), "crescent.liquidity.v1beta1.WithdrawRequest")
 	proto.RegisterType((*Order)(nil), "crescent.liquidity.v1beta1.Order")
+	proto.RegisterType((*IBCForward)(nil), "crescent.liquidity.v1beta1.IBCForward")
+	proto.RegisterType((*ICARequest)(nil), "crescent.liquidity.v1beta1.ICARequest")
 	proto.RegisterType((*MMOrderIndex)(nil), "crescent.liquidity.v1beta1.MMOrderIndex")
 }
 
@@ -586,135 +744,158 @@ func init() {
 }
 
 var fileDescriptor_c9be4f53a63dce2f = []byte{
-	// 2036 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0x17, 0x3f, 0x44, 0x91, 0x8f, 0xe6, 0x87, 0xc6, 0xb2, 0xbd, 0xa6, 0x6d, 0x8a, 0x15, 0x6a,
-	0x47, 0x15, 0x10, 0x32, 0x51, 0x53, 0x24, 0x06, 0xd2, 0x04, 0x14, 0xb9, 0x92, 0x89, 0x92, 0x12,
-	0xbd, 0xa4, 0x9a, 0x38, 0x28, 0xba, 0x18, 0xed, 0x8e, 0xa8, 0x81, 0xb8, 0x1f, 0xde, 0x5d, 0xea,
-	0x23, 0xa7, 0x1e, 0x5b, 0x9e, 0x72, 0x2a, 0x7a, 0xe1, 0xa5, 0xbd, 0x14, 0xfd, 0x0b, 0x7a, 0xed,
-	0xa1, 0x80, 0x8f, 0x39, 0x16, 0x3d, 0x24, 0xad, 0x7d, 0xeb, 0xa9, 0xe8, 0x5f, 0x50, 0xcc, 0xcc,
-	0xee, 0x72, 0x49, 0x3b, 0x8e, 0x45, 0xc4, 0x27, 0x71, 0xdf, 0xbc, 0xdf, 0xef, 0xcd, 0xbc, 0xaf,
-	0x79, 0x23, 0xd8, 0xd2, 0x1c, 0xe2, 0x6a, 0xc4, 0xf4, 0x6a, 0x43, 0xfa, 0x74, 0x44, 0x75, 0xea,
-	0x5d, 0xd6, 0xce, 0xde, 0x3f, 0x22, 0x1e, 0x7e, 0x7f, 0x2a, 0xa9, 0xda, 0x8e, 0xe5, 0x59, 0xa8,
-	0x14, 0xe8, 0x56, 0xa7, 0x2b, 0xbe, 0x6e, 0x69, 0x6d, 0x60, 0x0d, 0x2c, 0xae, 0x56, 0x63, 0xbf,
-	0x04, 0xa2, 0x54, 0xd6, 0x2c, 0xd7, 0xb0, 0xdc, 0xda, 0x11, 0x76, 0x49, 0x48, 0xab, 0x59, 0xd4,
-	0xf4, 0xd7, 0xd7, 0x07, 0x96, 0x35, 0x18, 0x92, 0x1a, 0xff, 0x3a, 0x1a, 0x1d, 0xd7, 0x3c, 0x6a,
-	0x10, 0xd7, 0xc3, 0x86, 0x1d, 0x10, 0xcc, 0x2b, 0xe8, 0x23, 0x07, 0x7b, 0xd4, 0xf2, 0x09, 0x36,
-	0x7e, 0x97, 0x85, 0x54, 0x17, 0x3b, 0xd8, 0x70, 0xd1, 0x3d, 0x80, 0x23, 0xec, 0x69, 0x27, 0xaa,
-	0x4b, 0xbf, 0x24, 0x52, 0xac, 0x12, 0xdb, 0xcc, 0x29, 0x19, 0x2e, 0xe9, 0xd1, 0x2f, 0x09, 0xba,
-	0x0f, 0x79, 0x8f, 0x6a, 0xa7, 0xaa, 0xed, 0x10, 0x8d, 0xba, 0xd4, 0x32, 0xa5, 0x38, 0x57, 0xc9,
-	0x31, 0x69, 0x37, 0x10, 0xa2, 0x6d, 0xb8, 0x71, 0x4c, 0x88, 0xaa, 0x59, 0xc3, 0x21, 0xd1, 0x3c,
-	0xcb, 0x51, 0xb1, 0xae, 0x3b, 0xc4, 0x75, 0xa5, 0x44, 0x25, 0xb6, 0x99, 0x51, 0xae, 0x1f, 0x13,
-	0xd2, 0x08, 0xd6, 0xea, 0x62, 0x09, 0x7d, 0x00, 0x37, 0xf5, 0x91, 0xeb, 0xbd, 0x02, 0x94, 0xe4,
-	0xa0, 0x35, 0xb6, 0xfa, 0x12, 0xca, 0x84, 0xbb, 0x06, 0x35, 0x55, 0x6a, 0x52, 0x8f, 0xe2, 0xa1,
-	0x6a, 0x5b, 0xd6, 0x50, 0x65, 0xae, 0x51, 0xdd, 0x91, 0x6d, 0x0f, 0x2f, 0xa5, 0x65, 0x86, 0xdd,
-	0xa9, 0x3e, 0xfb, 0x66, 0x7d, 0xe9, 0x9f, 0xdf, 0xac, 0x3f, 0x18, 0x50, 0xef, 0x64, 0x74, 0x54,
-	0xd5, 0x2c, 0xa3, 0xe6, 0x3b, 0x55, 0xfc, 0x79, 0xd7, 0xd5, 0x4f, 0x6b, 0xde, 0xa5, 0x4d, 0xdc,
-	0x6a, 0xcb, 0xf4, 0x14, 0xc9, 0xa0, 0x66, 0x4b, 0x50, 0x76, 0x2d, 0x6b, 0xd8, 0xb0, 0xa8, 0xd9,
-	0xe3, 0x7c, 0xe8, 0x1c, 0x56, 0x6d, 0x4c, 0x1d, 0x55, 0x73, 0x08, 0xf7, 0xa0, 0x7a, 0x4c, 0x88,
-	0x94, 0xaa, 0x24, 0x36, 0xb3, 0xdb, 0xb7, 0xab, 0x82, 0xab, 0xca, 0xe2, 0x14, 0x84, 0xb4, 0xca,
-	0xb0, 0x3b, 0xef, 0x31, 0xfb, 0x7f, 0xf9, 0x76, 0x7d, 0xf3, 0x0d, 0xec, 0x33, 0x80, 0xab, 0x14,
-	0x98, 0x95, 0x86, 0x6f, 0x64, 0x97, 0x10, 0x6e, 0x98, 0x1f, 0x2e, 0x6a, 0x78, 0xe5, 0x6d, 0x18,
-	0x66, 0x07, 0x8e, 0x18, 0x3e, 0x85, 0x52, 0xd4, 0xc3, 0x3a, 0xb1, 0x2d, 0x97, 0x7a, 0x2a, 0x36,
-	0xac, 0x91, 0xe9, 0x49, 0xe9, 0x85, 0xfc, 0x7b, 0x6b, 0xea, 0xdf, 0xa6, 0xe0, 0xab, 0x73, 0x3a,
-	0x84, 0xe1, 0x86, 0x81, 0x2f, 0x54, 0xdb, 0xa1, 0x1a, 0x51, 0x87, 0xd4, 0xa0, 0x9e, 0xca, 0x33,
-	0x55, 0xca, 0x5c, 0xd9, 0x4e, 0x93, 0x68, 0x0a, 0x32, 0xf0, 0x45, 0x97, 0x71, 0xb5, 0x19, 0x95,
-	0xc2, 0x98, 0xd0, 0x1e, 0xfc, 0x88, 0x99, 0x30, 0x47, 0x86, 0x6a, 0x60, 0xe7, 0x94, 0x78, 0xaa,
-	0x81, 0x4f, 0xa9, 0x39, 0x50, 0x2d, 0x47, 0x27, 0x8e, 0xca, 0x12, 0xd9, 0x95, 0x80, 0x67, 0xf5,
-	0x5d, 0x03, 0x5f, 0xec, 0x8f, 0x8c, 0x0e, 0x57, 0xeb, 0x70, 0xad, 0x03, 0xa6, 0xd4, 0x67, 0x3a,
-	0xe8, 0x31, 0x30, 0x7a, 0x1f, 0x36, 0xa4, 0xc7, 0xc4, 0xb5, 0xb1, 0x29, 0x65, 0x2b, 0x31, 0x1e,
-	0x12, 0x51, 0x72, 0xd5, 0xa0, 0xe4, 0xaa, 0x4d, 0xbf, 0xe4, 0x76, 0xd2, 0xec, 0x0c, 0x7f, 0xf8,
-	0x76, 0x3d, 0xa6, 0x14, 0x0d, 0x7c, 0xc1, 0xf9, 0xda, 0x3e, 0x18, 0x29, 0x90, 0x73, 0xcf, 0xb1,
-	0xcd, 0x62, 0xcb, 0xce, 0x4d, 0xa4, 0x6b, 0x0b, 0x1d, 0x3b, 0xcb, 0x48, 0x76, 0x09, 0x51, 0xb0,
-	0x47, 0xd0, 0x17, 0xb0, 0x7a, 0x4e, 0xbd, 0x13, 0xdd, 0xc1, 0xe7, 0x53, 0xde, 0xdc, 0x42, 0xbc,
-	0x85, 0x80, 0x28, 0xc2, 0x1d, 0xe4, 0x03, 0xb9, 0xf0, 0x1c, 0xac, 0x0e, 0xb0, 0x2b, 0xe5, 0x2b,
-	0xb1, 0xcd, 0xe4, 0x95, 0xb8, 0xf7, 0xb0, 0xab, 0x14, 0x7c, 0x22, 0x99, 0xf1, 0xec, 0x61, 0x17,
-	0xfd, 0x0a, 0x50, 0xb8, 0xef, 0x29, 0x79, 0x61, 0x21, 0xf2, 0x62, 0xc0, 0x14, 0xb2, 0xff, 0x12,
-	0x0a, 0x22, 0x70, 0x53, 0xea, 0xe2, 0x42, 0xd4, 0x39, 0x4e, 0x13, 0xf2, 0x7e, 0x0a, 0xf7, 0x82,
-	0xec, 0xc2, 0x9a, 0x47, 0xcf, 0x08, 0x6f, 0x49, 0xae, 0x6a, 0x13, 0x47, 0x65, 0x25, 0x2d, 0xad,
-	0xf2, 0xcc, 0x92, 0x44, 0x66, 0xd5, 0xb9, 0x0a, 0x6b, 0x31, 0x6e, 0x97, 0x38, 0x5d, 0x4c, 0x9d,
-	0x8d, 0x3f, 0xc7, 0x21, 0xc9, 0x7e, 0xa0, 0x3c, 0xc4, 0xa9, 0xce, 0x3b, 0x70, 0x52, 0x89, 0x53,
-	0x1d, 0x3d, 0x80, 0x02, 0xab, 0x6f, 0xd1, 0xdd, 0x74, 0x62, 0x5a, 0x06, 0xef, 0xbd, 0x19, 0x25,
-	0xc7, 0xc4, 0xac, 0x78, 0x9b, 0x4c, 0x88, 0x36, 0xa1, 0xf8, 0x74, 0x64, 0x79, 0x33, 0x8a, 0xa2,
-	0xed, 0xe6, 0xb9, 0x7c, 0xaa, 0x79, 0x1f, 0xf2, 0xc4, 0xd5, 0x1c, 0xeb, 0x7c, 0xae, 0xd3, 0xe6,
-	0x84, 0x34, 0x68, 0xb1, 0x1b, 0x90, 0x1b, 0x62, 0xd7, 0xf3, 0x13, 0x9d, 0xea, 0xbc, 0xa7, 0x26,
-	0x95, 0x2c, 0x13, 0xf2, 0xf4, 0x6d, 0xe9, 0xa8, 0x05, 0xc0, 0x75, 0x78, 0xe1, 0x4a, 0x29, 0x9e,
-	0x5d, 0x5b, 0x57, 0xc8, 0xac, 0x0c, 0x43, 0xf3, 0x4a, 0x65, 0xfb, 0xd7, 0x46, 0x8e, 0x43, 0x4c,
-	0x4f, 0x15, 0x37, 0x11, 0xd5, 0xa5, 0x15, 0x6e, 0x31, 0xef, 0xcb, 0x77, 0x98, 0xb8, 0xa5, 0x6f,
-	0xfc, 0x2f, 0x01, 0x49, 0xe6, 0x3b, 0xf4, 0x11, 0x24, 0x19, 0x15, 0x77, 0x56, 0x7e, 0xfb, 0xc7,
-	0xd5, 0xef, 0xbe, 0x61, 0xab, 0x4c, 0xbf, 0x7f, 0x69, 0x13, 0x85, 0x23, 0x7c, 0x27, 0xc7, 0x43,
-	0x27, 0xdf, 0x82, 0x15, 0xde, 0xde, 0xa9, 0xce, 0x7d, 0x96, 0x54, 0x52, 0xec, 0xb3, 0xa5, 0x23,
-	0x09, 0x56, 0x78, 0xe7, 0xb5, 0x1c, 0xdf, 0x49, 0xc1, 0x27, 0x7a, 0x07, 0x0a, 0x0e, 0x71, 0x89,
-	0x73, 0x46, 0x42, 0x37, 0x2e, 0x0b, 0x77, 0xfb, 0xe2, 0xc0, 0x8f, 0x0f, 0xa0, 0x30, 0xbd, 0x9e,
-	0x44, 0x5c, 0x52, 0xc2, 0xdf, 0xb6, 0x7f, 0xc7, 0x88, 0xb0, 0xec, 0x41, 0x86, 0x35, 0x5c, 0xe1,
-	0xca, 0x95, 0x2b, 0xbb, 0x32, 0x6d, 0x50, 0x53, 0x78, 0x92, 0x11, 0x05, 0xcd, 0xd4, 0x6f, 0xd4,
-	0x57, 0x23, 0xf2, 0x9b, 0x27, 0xfa, 0x19, 0xdc, 0xe2, 0xd1, 0x0d, 0x6a, 0xdd, 0x21, 0x4f, 0x47,
-	0xc4, 0xf5, 0x98, 0x97, 0x32, 0xdc, 0x4b, 0x6b, 0x6c, 0xd9, 0xef, 0xe4, 0x8a, 0x58, 0x6c, 0xe9,
-	0xe8, 0x43, 0x90, 0x38, 0x2c, 0x2c, 0xe3, 0x08, 0x0e, 0x38, 0xee, 0x06, 0x5b, 0xff, 0xcc, 0x5f,
-	0x9e, 0x02, 0x4b, 0x90, 0xd6, 0xa9, 0x8b, 0x8f, 0x86, 0x44, 0xe7, 0xfd, 0x34, 0xad, 0x84, 0xdf,
-	0x1b, 0xff, 0x49, 0x40, 0x7e, 0xd6, 0xd2, 0x4b, 0x95, 0xc2, 0x82, 0xc8, 0x1c, 0x1d, 0x46, 0x36,
-	0xc5, 0x3e, 0x5b, 0x3a, 0x1b, 0x6e, 0x0c, 0x77, 0xa0, 0x9e, 0x10, 0x3a, 0x38, 0xf1, 0x78, 0x80,
-	0x13, 0x4a, 0xc6, 0x70, 0x07, 0x8f, 0xb8, 0x00, 0xdd, 0x85, 0x8c, 0x7f, 0xc2, 0x30, 0xca, 0x53,
-	0x01, 0xb2, 0x21, 0x17, 0x9c, 0x9f, 0x45, 0x90, 0x45, 0xf9, 0x07, 0xbf, 0x7c, 0xaf, 0xf9, 0x16,
-	0xf8, 0x17, 0x72, 0x20, 0x8f, 0x35, 0x8d, 0xd8, 0x1e, 0xd1, 0x7d, 0x93, 0x6f, 0x61, 0xd0, 0xc8,
-	0x05, 0x26, 0x84, 0xcd, 0x16, 0x14, 0x0d, 0x6a, 0x32, 0x8b, 0x61, 0xae, 0xf2, 0x1c, 0x7c, 0xad,
-	0xd5, 0x24, 0xb3, 0xaa, 0xe4, 0x05, 0x30, 0x18, 0x98, 0x50, 0x1d, 0x52, 0xae, 0x87, 0xbd, 0x91,
-	0xcb, 0x73, 0x2f, 0xbf, 0xfd, 0x93, 0xd7, 0xd5, 0xa5, 0x1f, 0xcb, 0x1e, 0x07, 0x28, 0x3e, 0x70,
-	0xe3, 0xbf, 0x71, 0x28, 0xcc, 0xa5, 0xc7, 0x0f, 0x16, 0xed, 0x32, 0x40, 0x90, 0x98, 0x24, 0x08,
-	0x77, 0x44, 0x82, 0x3e, 0x86, 0xcc, 0xd4, 0x05, 0xcb, 0x6f, 0xe6, 0x82, 0x74, 0x50, 0xc9, 0xc8,
-	0x83, 0xf0, 0xb2, 0x34, 0xdf, 0x5e, 0xf0, 0xf2, 0xa1, 0x0d, 0x11, 0xbd, 0xa9, 0xcb, 0x57, 0x16,
-	0x75, 0xf9, 0xdf, 0x53, 0xb0, 0xcc, 0xbb, 0x3a, 0x7a, 0x38, 0xd3, 0x55, 0xef, 0xbf, 0x8e, 0x4a,
-	0x4c, 0x45, 0x0b, 0xb4, 0xd5, 0xd9, 0x18, 0x25, 0xe7, 0x63, 0x24, 0xc1, 0x0a, 0xbf, 0x75, 0x88,
-	0xe3, 0xf7, 0xd4, 0xe0, 0x13, 0x3d, 0x82, 0x8c, 0x4e, 0x1d, 0xa2, 0xb1, 0x91, 0x8a, 0xb7, 0xd1,
-	0xfc, 0xf6, 0xd6, 0xf7, 0xee, 0xb0, 0x19, 0x20, 0x94, 0x29, 0x18, 0x7d, 0x02, 0x60, 0x1d, 0x1f,
-	0x13, 0xe7, 0x4a, 0xb9, 0x9e, 0xe1, 0x10, 0x1e, 0xe9, 0xc7, 0xb0, 0xe6, 0x10, 0x03, 0x53, 0x93,
-	0xcf, 0x90, 0x53, 0xa6, 0xf4, 0x9b, 0x31, 0xa1, 0x10, 0x7c, 0x10, 0x52, 0x36, 0x21, 0xe7, 0x10,
-	0x8d, 0xd0, 0x33, 0xbf, 0xf0, 0x79, 0x97, 0x7d, 0x03, 0xae, 0x6b, 0x01, 0xca, 0x67, 0x59, 0x16,
-	0xad, 0x1f, 0x16, 0x1a, 0xf6, 0x04, 0x18, 0xed, 0x42, 0xca, 0x1f, 0xf5, 0xb3, 0x0b, 0x8d, 0xfa,
-	0x3e, 0x1a, 0x1d, 0x40, 0xd6, 0xb2, 0x89, 0x19, 0xbc, 0x1b, 0xae, 0x2d, 0x44, 0x06, 0x8c, 0xc2,
-	0x7f, 0x2a, 0xdc, 0x86, 0x74, 0x38, 0x1f, 0xe4, 0x78, 0x52, 0xad, 0x1c, 0x89, 0xc1, 0x00, 0xd5,
-	0x21, 0x43, 0x2e, 0x6c, 0xea, 0x10, 0x15, 0x7b, 0x7c, 0x1c, 0xcd, 0x6e, 0x97, 0x5e, 0x1a, 0xc8,
-	0xfb, 0xc1, 0x23, 0x59, 0x4c, 0xe4, 0x5f, 0xb1, 0x89, 0x3c, 0x2d, 0x60, 0x75, 0x0f, 0x7d, 0x1a,
-	0x56, 0x52, 0x81, 0x27, 0xd7, 0x3b, 0xdf, 0x9b, 0x5c, 0x73, 0x75, 0xf4, 0x6b, 0xb8, 0xd6, 0xe9,
-	0x88, 0xf1, 0xc8, 0xd4, 0xc9, 0x45, 0x34, 0x95, 0x63, 0xb3, 0xa9, 0x1c, 0x29, 0x8e, 0xf8, 0x4c,
-	0x71, 0xdc, 0x81, 0x4c, 0x30, 0x73, 0xb1, 0x97, 0x73, 0x62, 0x33, 0xa9, 0xa4, 0x2d, 0x31, 0x70,
-	0xb9, 0x5b, 0xbf, 0x8f, 0x41, 0x3a, 0x18, 0x66, 0xd8, 0x7b, 0xbb, 0x7b, 0x70, 0xd0, 0x56, 0xfb,
-	0x4f, 0xba, 0xb2, 0x7a, 0xb8, 0xdf, 0xeb, 0xca, 0x8d, 0xd6, 0x6e, 0x4b, 0x6e, 0x16, 0x97, 0x4a,
-	0xb7, 0xc6, 0x93, 0xca, 0xf5, 0x40, 0xf1, 0xd0, 0x74, 0x6d, 0xa2, 0xd1, 0x63, 0x4a, 0xf8, 0x3c,
-	0x39, 0xc5, 0xec, 0xd4, 0x7b, 0xad, 0x46, 0x31, 0x56, 0x5a, 0x1d, 0x4f, 0x2a, 0xb9, 0x40, 0x7b,
-	0x07, 0xbb, 0x54, 0x63, 0xf3, 0xd8, 0x54, 0x4f, 0xa9, 0xef, 0xef, 0xc9, 0xcd, 0x62, 0xbc, 0x84,
-	0xc6, 0x93, 0x4a, 0x3e, 0x1c, 0xa6, 0xb0, 0x39, 0x20, 0x7a, 0x29, 0xf9, 0xdb, 0x3f, 0x95, 0x97,
-	0xb6, 0xfe, 0x16, 0x83, 0x4c, 0xd8, 0x0f, 0xd8, 0xab, 0xfe, 0x40, 0x69, 0xca, 0xca, 0xab, 0xb6,
-	0x26, 0x8d, 0x27, 0x95, 0xb5, 0x50, 0x35, 0xba, 0xb7, 0x4d, 0x28, 0x46, 0x50, 0xed, 0x56, 0xa7,
-	0xd5, 0x2f, 0xc6, 0x84, 0xcd, 0x50, 0x9f, 0x3f, 0xe9, 0xd0, 0x16, 0xac, 0x46, 0x34, 0x3b, 0x75,
-	0xe5, 0x17, 0x72, 0xbf, 0x18, 0x2f, 0x5d, 0x1f, 0x4f, 0x2a, 0x85, 0x50, 0x55, 0x3c, 0xe0, 0xd8,
-	0x20, 0x1b, 0xd5, 0xed, 0x14, 0x13, 0xa5, 0xc2, 0x78, 0x52, 0xc9, 0x4e, 0xf5, 0x3a, 0xfe, 0x19,
-	0xfe, 0x1a, 0x83, 0xfc, 0x6c, 0xc7, 0x40, 0x9f, 0xc0, 0x1d, 0x01, 0x6e, 0xb6, 0x14, 0xb9, 0xd1,
-	0x6f, 0x1d, 0xec, 0xcf, 0x9d, 0xe6, 0xde, 0x78, 0x52, 0xb9, 0x3d, 0x0b, 0x8a, 0x1e, 0xa9, 0x0a,
-	0xd7, 0xe7, 0xf1, 0x3b, 0x87, 0x4f, 0x8a, 0xb1, 0xd2, 0x8d, 0xf1, 0xa4, 0xb2, 0x3a, 0x8b, 0xdb,
-	0x19, 0x5d, 0xa2, 0xf7, 0x60, 0x6d, 0x5e, 0xbf, 0x27, 0xb7, 0xdb, 0xc5, 0x78, 0xe9, 0xe6, 0x78,
-	0x52, 0x41, 0xb3, 0x80, 0x1e, 0x19, 0x0e, 0xfd, 0xad, 0xff, 0x26, 0x0e, 0xb9, 0x99, 0xce, 0x8e,
-	0x3e, 0x86, 0x92, 0x22, 0x3f, 0x3e, 0x94, 0x7b, 0x7d, 0xb5, 0xd7, 0xaf, 0xf7, 0x0f, 0x7b, 0x73,
-	0x1b, 0xbf, 0x3b, 0x9e, 0x54, 0xa4, 0x19, 0x48, 0x74, 0xdf, 0x3f, 0x87, 0x3b, 0x73, 0xe8, 0xfd,
-	0x83, 0xbe, 0x2a, 0x7f, 0x2e, 0x37, 0x0e, 0xfb, 0x72, 0xb3, 0x18, 0x7b, 0x05, 0x7c, 0xdf, 0xf2,
-	0xe4, 0x0b, 0xa2, 0x8d, 0x3c, 0xa2, 0xa3, 0x8f, 0x40, 0x9a, 0x83, 0xf7, 0x0e, 0x1b, 0x0d, 0x59,
-	0x6e, 0xf2, 0x2c, 0x2a, 0x8d, 0x27, 0x95, 0x9b, 0x33, 0xd8, 0xde, 0x48, 0xd3, 0x08, 0xd1, 0x89,
-	0xce, 0x72, 0x7a, 0x0e, 0xb9, 0x5b, 0x6f, 0xb5, 0xe5, 0x66, 0x31, 0x21, 0x72, 0x7a, 0x06, 0xb6,
-	0x8b, 0xe9, 0x30, 0xcc, 0xc0, 0x3f, 0x26, 0x20, 0x1b, 0x29, 0x49, 0xb6, 0x07, 0xe1, 0xca, 0x57,
-	0x1e, 0x9f, 0xef, 0x21, 0xa2, 0x1e, 0x3d, 0xfc, 0x43, 0xb8, 0x3d, 0x83, 0x9c, 0x3b, 0xfa, 0x3c,
-	0x34, 0x7a, 0xf0, 0x0f, 0xe7, 0x8c, 0x32, 0x68, 0xa7, 0xde, 0x6f, 0x3c, 0xe2, 0x07, 0xbf, 0x3d,
-	0x9e, 0x54, 0x6e, 0xcc, 0x22, 0x3b, 0xac, 0x79, 0x11, 0x1d, 0x35, 0xa0, 0x3c, 0x03, 0xec, 0xd6,
-	0x95, 0x7e, 0xab, 0xde, 0x6e, 0x3f, 0x09, 0xe1, 0x89, 0xd2, 0xfa, 0x78, 0x52, 0xb9, 0x13, 0x81,
-	0x77, 0xb1, 0xe3, 0x51, 0x3c, 0x1c, 0x5e, 0x06, 0x24, 0x61, 0xd9, 0xf9, 0x24, 0x8d, 0x83, 0x4e,
-	0xb7, 0x2d, 0xb3, 0x5d, 0x27, 0x23, 0x65, 0x27, 0xc0, 0x0d, 0xcb, 0xb0, 0x87, 0xc4, 0x13, 0x2e,
-	0x9f, 0x45, 0xd5, 0xf7, 0x1b, 0x32, 0x73, 0xf9, 0xb2, 0x70, 0x79, 0x14, 0x84, 0x4d, 0x8d, 0x0c,
-	0x89, 0x3e, 0xcd, 0x53, 0x1f, 0x23, 0x7f, 0xde, 0x6d, 0x29, 0x72, 0xb3, 0x98, 0x8a, 0xe4, 0xa9,
-	0x80, 0xc8, 0xbc, 0xb7, 0xfa, 0x41, 0xda, 0xf9, 0xec, 0xd9, 0xbf, 0xcb, 0x4b, 0xcf, 0x9e, 0x97,
-	0x63, 0x5f, 0x3f, 0x2f, 0xc7, 0xfe, 0xf5, 0xbc, 0x1c, 0xfb, 0xea, 0x45, 0x79, 0xe9, 0xeb, 0x17,
-	0xe5, 0xa5, 0x7f, 0xbc, 0x28, 0x2f, 0x7d, 0xf1, 0x30, 0x7a, 0x21, 0xf8, 0x8d, 0xf7, 0x5d, 0x93,
-	0x78, 0xe7, 0x96, 0x73, 0x1a, 0x0a, 0x6a, 0x67, 0x1f, 0xd4, 0x2e, 0x22, 0xff, 0x71, 0xe5, 0xf7,
-	0xc4, 0x51, 0x8a, 0x77, 0xf8, 0x9f, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x21, 0x50, 0xe1, 0x95,
-	0x94, 0x15, 0x00, 0x00,
+	// 2405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x73, 0xdb, 0xc6,
+	0xd9, 0x17, 0x3f, 0x44, 0x89, 0x8f, 0xc4, 0x0f, 0xad, 0x65, 0x1b, 0xa2, 0x6c, 0x89, 0xaf, 0xf2,
+	0xda, 0x51, 0x35, 0x2d, 0x95, 0xa8, 0xed, 0x24, 0x9e, 0x26, 0x76, 0x29, 0x12, 0x92, 0x31, 0x25,
+	0x45, 0x1a, 0xa4, 0xd2, 0x38, 0xd3, 0x29, 0x06, 0x02, 0x96, 0xd4, 0x8e, 0x08, 0x80, 0x5e, 0x80,
+	0x96, 0x94, 0x53, 0x4f, 0x9d, 0x0e, 0x4f, 0x39, 0x75, 0x7a, 0xe1, 0xa5, 0xbd, 0xf5, 0xd6, 0x4b,
+	0xa7, 0xd7, 0xde, 0x7c, 0xcc, 0xa9, 0xd3, 0xe9, 0x74, 0x92, 0xd6, 0xbe, 0xf5, 0xd4, 0x3f, 0xa0,
+	0x87, 0xce, 0xee, 0x02, 0x20, 0x48, 0xab, 0xb2, 0xa2, 0x89, 0x4f, 0xf2, 0x2e, 0x9e, 0xdf, 0xef,
+	0xd9, 0xe7, 0x7b, 0x97, 0x86, 0x2d, 0x83, 0x62, 0xd7, 0xc0, 0xb6, 0xb7, 0xdd, 0x23, 0xcf, 0x06,
+	0xc4, 0x24, 0xde, 0xf9, 0xf6, 0xf3, 0xf7, 0x8f, 0xb0, 0xa7, 0xbf, 0x3f, 0xde, 0x29, 0xf5, 0xa9,
+	0xe3, 0x39, 0xa8, 0x10, 0xc8, 0x96, 0xc6, 0x5f, 0x7c, 0xd9, 0xc2, 0x72, 0xd7, 0xe9, 0x3a, 0x5c,
+	0x6c, 0x9b, 0xfd, 0x4b, 0x20, 0x0a, 0x6b, 0x86, 0xe3, 0x5a, 0x8e, 0xbb, 0x7d, 0xa4, 0xbb, 0x38,
+	0xa4, 0x35, 0x1c, 0x62, 0xfb, 0xdf, 0xd7, 0xbb, 0x8e, 0xd3, 0xed, 0xe1, 0x6d, 0xbe, 0x3a, 0x1a,
+	0x74, 0xb6, 0x3d, 0x62, 0x61, 0xd7, 0xd3, 0xad, 0x7e, 0x40, 0x30, 0x2d, 0x60, 0x0e, 0xa8, 0xee,
+	0x11, 0xc7, 0x27, 0xd8, 0xf8, 0xc3, 0x22, 0xa4, 0x9a, 0x3a, 0xd5, 0x2d, 0x17, 0xdd, 0x05, 0x38,
+	0xd2, 0x3d, 0xe3, 0x58, 0x73, 0xc9, 0xe7, 0x58, 0x8a, 0x15, 0x63, 0x9b, 0x19, 0x35, 0xcd, 0x77,
+	0x5a, 0xe4, 0x73, 0x8c, 0xee, 0x41, 0xd6, 0x23, 0xc6, 0x89, 0xd6, 0xa7, 0xd8, 0x20, 0x2e, 0x71,
+	0x6c, 0x29, 0xce, 0x45, 0x32, 0x6c, 0xb7, 0x19, 0x6c, 0xa2, 0x1d, 0xb8, 0xd9, 0xc1, 0x58, 0x33,
+	0x9c, 0x5e, 0x0f, 0x1b, 0x9e, 0x43, 0x35, 0xdd, 0x34, 0x29, 0x76, 0x5d, 0x29, 0x51, 0x8c, 0x6d,
+	0xa6, 0xd5, 0x1b, 0x1d, 0x8c, 0x2b, 0xc1, 0xb7, 0xb2, 0xf8, 0x84, 0x7e, 0x00, 0xb7, 0xcc, 0x81,
+	0xeb, 0x5d, 0x00, 0x4a, 0x72, 0xd0, 0x32, 0xfb, 0xfa, 0x1a, 0xca, 0x86, 0x3b, 0x16, 0xb1, 0x35,
+	0x62, 0x13, 0x8f, 0xe8, 0x3d, 0xad, 0xef, 0x38, 0x3d, 0x8d, 0xb9, 0x46, 0x73, 0x07, 0xfd, 0x7e,
+	0xef, 0x5c, 0x9a, 0x65, 0xd8, 0xdd, 0xd2, 0x8b, 0xaf, 0xd6, 0x67, 0xfe, 0xf6, 0xd5, 0xfa, 0xfd,
+	0x2e, 0xf1, 0x8e, 0x07, 0x47, 0x25, 0xc3, 0xb1, 0xb6, 0x7d, 0xa7, 0x8a, 0x3f, 0xdf, 0x73, 0xcd,
+	0x93, 0x6d, 0xef, 0xbc, 0x8f, 0xdd, 0x92, 0x62, 0x7b, 0xaa, 0x64, 0x11, 0x5b, 0x11, 0x94, 0x4d,
+	0xc7, 0xe9, 0x55, 0x1c, 0x62, 0xb7, 0x38, 0x1f, 0x3a, 0x85, 0xa5, 0xbe, 0x4e, 0xa8, 0x66, 0x50,
+	0xcc, 0x3d, 0xa8, 0x75, 0x30, 0x96, 0x52, 0xc5, 0xc4, 0xe6, 0xc2, 0xce, 0x4a, 0x49, 0x70, 0x95,
+	0x58, 0x9c, 0x82, 0x90, 0x96, 0x18, 0x76, 0xf7, 0x3d, 0xa6, 0xff, 0xf7, 0x5f, 0xaf, 0x6f, 0x5e,
+	0x41, 0x3f, 0x03, 0xb8, 0x6a, 0x8e, 0x69, 0xa9, 0xf8, 0x4a, 0xf6, 0x30, 0xe6, 0x8a, 0xb9, 0x71,
+	0x51, 0xc5, 0x73, 0x6f, 0x43, 0x31, 0x33, 0x38, 0xa2, 0xf8, 0x04, 0x0a, 0x51, 0x0f, 0x9b, 0xb8,
+	0xef, 0xb8, 0xc4, 0xd3, 0x74, 0xcb, 0x19, 0xd8, 0x9e, 0x34, 0x7f, 0x2d, 0xff, 0xde, 0x1e, 0xfb,
+	0xb7, 0x2a, 0xf8, 0xca, 0x9c, 0x0e, 0x7d, 0x02, 0x37, 0x2d, 0xfd, 0x4c, 0xeb, 0x53, 0x62, 0x60,
+	0xad, 0x47, 0x2c, 0xe2, 0x69, 0x3c, 0x53, 0xa5, 0x34, 0xd7, 0xf3, 0x8e, 0xaf, 0x67, 0x55, 0xb0,
+	0xba, 0xe6, 0x49, 0x89, 0x38, 0xdb, 0x96, 0xee, 0x1d, 0x97, 0x6a, 0xb8, 0xab, 0x1b, 0xe7, 0x55,
+	0x6c, 0xa8, 0xc8, 0xd2, 0xcf, 0x9a, 0x8c, 0xa0, 0xc6, 0xf0, 0x2a, 0x83, 0xa3, 0x7d, 0xf8, 0x3f,
+	0xc6, 0x6b, 0x0f, 0x2c, 0xcd, 0xd2, 0xe9, 0x09, 0xf6, 0x34, 0x4b, 0x3f, 0x21, 0x76, 0x57, 0x73,
+	0xa8, 0x89, 0xa9, 0xc6, 0xb2, 0xd7, 0x95, 0x80, 0xa7, 0xf2, 0x1d, 0x4b, 0x3f, 0x3b, 0x18, 0x58,
+	0x75, 0x2e, 0x56, 0xe7, 0x52, 0x0d, 0x26, 0xd4, 0x66, 0x32, 0xe8, 0x09, 0x30, 0x7a, 0x1f, 0xd6,
+	0x23, 0x1d, 0xec, 0xf6, 0x75, 0x5b, 0x5a, 0x28, 0xc6, 0x78, 0x1c, 0x44, 0x9d, 0x95, 0x82, 0x3a,
+	0x2b, 0x55, 0xfd, 0x3a, 0xdb, 0x9d, 0x67, 0x07, 0xff, 0xcd, 0xd7, 0xeb, 0x31, 0x35, 0x6f, 0xe9,
+	0x67, 0x9c, 0xaf, 0xe6, 0x83, 0xd1, 0x3e, 0x64, 0xdc, 0x53, 0xbd, 0xcf, 0x02, 0xca, 0x8c, 0xc5,
+	0xd2, 0xe2, 0xd5, 0x6d, 0x5d, 0x60, 0xc8, 0x3d, 0x8c, 0x55, 0xdd, 0xc3, 0xa8, 0x01, 0x4b, 0xa7,
+	0xc4, 0x3b, 0x36, 0xa9, 0x7e, 0x3a, 0x26, 0xcb, 0x5c, 0x9d, 0x2c, 0x17, 0xa0, 0x03, 0xc2, 0xcf,
+	0x60, 0x29, 0x08, 0x37, 0x3e, 0xf3, 0xa8, 0xae, 0x75, 0x75, 0x57, 0xca, 0x16, 0x63, 0x9b, 0xc9,
+	0x6f, 0x14, 0xf1, 0x7d, 0xdd, 0x55, 0x73, 0x3e, 0x91, 0xcc, 0x78, 0xf6, 0x75, 0x17, 0xfd, 0x0c,
+	0x50, 0x78, 0xd8, 0x31, 0x79, 0xee, 0x5a, 0xe4, 0xf9, 0x80, 0x29, 0x64, 0xff, 0x04, 0x72, 0x22,
+	0x44, 0x63, 0xea, 0xfc, 0xb5, 0xa8, 0x33, 0x9c, 0x26, 0xe4, 0x7d, 0x04, 0x77, 0x83, 0x3c, 0xd2,
+	0x0d, 0x8f, 0x3c, 0xc7, 0xbc, 0xe3, 0xb8, 0x5a, 0x1f, 0x53, 0x8d, 0x55, 0xac, 0xb4, 0xc4, 0x73,
+	0x48, 0x12, 0x39, 0x54, 0xe6, 0x22, 0xac, 0x83, 0xb8, 0x4d, 0x4c, 0x9b, 0x3a, 0xa1, 0x68, 0x1f,
+	0x8a, 0x1d, 0x62, 0x13, 0xf7, 0x18, 0x9b, 0x1a, 0xc5, 0x86, 0x43, 0xd9, 0x1f, 0x0f, 0xdb, 0xbc,
+	0xa4, 0x8f, 0x7a, 0x0e, 0xcb, 0x43, 0xc4, 0x39, 0xee, 0x06, 0x72, 0x2a, 0x17, 0x53, 0x03, 0xa9,
+	0x5d, 0x2e, 0xc4, 0x1a, 0x35, 0x8b, 0xb1, 0x89, 0x6d, 0xc7, 0x72, 0xa5, 0x1b, 0xc5, 0xc4, 0x66,
+	0x5a, 0x4d, 0x77, 0x30, 0xae, 0xf2, 0x0d, 0xa4, 0xc1, 0x32, 0xab, 0xda, 0x0e, 0x16, 0x27, 0xd4,
+	0x28, 0x76, 0x31, 0x7d, 0x8e, 0xa5, 0xe5, 0x6b, 0xd5, 0xeb, 0x92, 0x45, 0x58, 0x2f, 0x60, 0x96,
+	0xa8, 0x82, 0x68, 0x63, 0x14, 0x87, 0x24, 0xb7, 0x28, 0x0b, 0x71, 0x62, 0xf2, 0x49, 0x91, 0x54,
+	0xe3, 0xc4, 0x44, 0xf7, 0x21, 0xc7, 0xfa, 0x90, 0xe8, 0xc2, 0xfc, 0x78, 0x7c, 0x46, 0xa4, 0xd5,
+	0x0c, 0xdb, 0x66, 0x4d, 0x86, 0x1f, 0x11, 0x6d, 0x42, 0xfe, 0xd9, 0xc0, 0xf1, 0x26, 0x04, 0xc5,
+	0x78, 0xc8, 0xf2, 0xfd, 0xb1, 0xe4, 0x3d, 0xc8, 0x62, 0xd7, 0xa0, 0xce, 0xe9, 0xd4, 0x44, 0xc8,
+	0x88, 0xdd, 0x60, 0x14, 0x6c, 0x40, 0xa6, 0xa7, 0xbb, 0x9e, 0x5f, 0x9b, 0xc4, 0xe4, 0xbd, 0x3f,
+	0xa9, 0x2e, 0xb0, 0x4d, 0x5e, 0x71, 0x8a, 0x89, 0x1e, 0x02, 0x70, 0x19, 0xde, 0x60, 0xa4, 0x14,
+	0x77, 0xc6, 0xfa, 0x9b, 0xea, 0x22, 0xcd, 0x20, 0xbc, 0xa3, 0xb0, 0x43, 0x1b, 0x03, 0x4a, 0xb1,
+	0xed, 0x69, 0x62, 0x4c, 0x12, 0x53, 0x9a, 0xe3, 0x6a, 0xb2, 0xfe, 0xfe, 0x2e, 0xdb, 0x56, 0xcc,
+	0x8d, 0xbf, 0x27, 0x20, 0xc9, 0xfc, 0x85, 0x3e, 0x84, 0x24, 0x73, 0x24, 0xf7, 0x50, 0x76, 0xe7,
+	0xff, 0x4b, 0xff, 0x7b, 0xfc, 0x97, 0x98, 0x7c, 0xfb, 0xbc, 0x8f, 0x55, 0x8e, 0xf0, 0x3d, 0x1b,
+	0x0f, 0x3d, 0x7b, 0x1b, 0xe6, 0xf8, 0xec, 0x21, 0x26, 0x77, 0x54, 0x52, 0x4d, 0xb1, 0xa5, 0x62,
+	0x22, 0x09, 0xe6, 0xf8, 0x58, 0x70, 0xa8, 0xef, 0x99, 0x60, 0x89, 0xde, 0x85, 0x9c, 0x1f, 0xf9,
+	0xd0, 0x77, 0xb3, 0xc2, 0xc7, 0xfe, 0x76, 0xe0, 0xbc, 0xfb, 0x90, 0x1b, 0xcf, 0x4e, 0x11, 0x8c,
+	0x94, 0x70, 0x72, 0xdf, 0x1f, 0x80, 0x22, 0x16, 0x1f, 0x41, 0x9a, 0xe5, 0x95, 0xf0, 0xdf, 0xdc,
+	0xd5, 0xfc, 0x37, 0x6f, 0x11, 0x5b, 0xb8, 0x8f, 0xa1, 0x83, 0xf6, 0x2e, 0xcd, 0x5f, 0x15, 0xed,
+	0xb7, 0x73, 0xf4, 0x43, 0xb8, 0xcd, 0x83, 0x17, 0xf4, 0x24, 0x8a, 0x9f, 0x0d, 0xb0, 0xeb, 0x31,
+	0x7f, 0xa4, 0xb9, 0x3f, 0x96, 0xd9, 0x67, 0x7f, 0xa0, 0xa8, 0xe2, 0xa3, 0x62, 0xa2, 0x0f, 0x40,
+	0xe2, 0xb0, 0xb0, 0xdd, 0x44, 0x70, 0xc0, 0x71, 0x37, 0xd9, 0xf7, 0x9f, 0xfa, 0x9f, 0xc7, 0xc0,
+	0x02, 0xcc, 0x9b, 0xc4, 0xd5, 0x8f, 0x7a, 0xd8, 0xe4, 0x1d, 0x7e, 0x5e, 0x0d, 0xd7, 0x1b, 0xff,
+	0x4a, 0x40, 0x76, 0x52, 0xd3, 0x6b, 0x85, 0xc0, 0xc2, 0xc5, 0x5c, 0x1a, 0xc6, 0x30, 0xc5, 0x96,
+	0x8a, 0xc9, 0x4a, 0xd7, 0x72, 0xbb, 0xda, 0x31, 0x26, 0xdd, 0x63, 0x8f, 0x87, 0x32, 0xa1, 0xa6,
+	0x2d, 0xb7, 0xfb, 0x98, 0x6f, 0xa0, 0x3b, 0x90, 0xf6, 0x2d, 0x0c, 0xe3, 0x39, 0xde, 0x40, 0x7d,
+	0xc8, 0xf8, 0x0b, 0x1e, 0x2b, 0x16, 0xcf, 0x6f, 0xfd, 0x0e, 0xb0, 0xe8, 0x6b, 0xe0, 0x2b, 0x44,
+	0x21, 0xab, 0x1b, 0x06, 0xee, 0x7b, 0xd8, 0xf4, 0x55, 0xbe, 0x85, 0xfb, 0x4e, 0x26, 0x50, 0x21,
+	0x74, 0x2a, 0x90, 0xb7, 0x88, 0xcd, 0x34, 0x86, 0x59, 0xc9, 0xb3, 0xed, 0x52, 0xad, 0x49, 0xa6,
+	0x55, 0xcd, 0x0a, 0x60, 0x70, 0x6f, 0x43, 0x65, 0x48, 0xb9, 0x9e, 0xee, 0x0d, 0x5c, 0x9e, 0x70,
+	0xd9, 0x9d, 0xef, 0x5c, 0x56, 0x81, 0x7e, 0x2c, 0x5b, 0x1c, 0xa0, 0xfa, 0xc0, 0x8d, 0x7f, 0xc7,
+	0x21, 0x37, 0x95, 0x1e, 0xdf, 0x5a, 0xb4, 0xd7, 0x00, 0x82, 0xc4, 0xc4, 0x41, 0xb8, 0x23, 0x3b,
+	0xac, 0x64, 0xc6, 0x2e, 0x98, 0xbd, 0x9a, 0x0b, 0xe6, 0x83, 0x9a, 0x45, 0x1e, 0x84, 0x43, 0xdd,
+	0x7e, 0x7b, 0xc1, 0xcb, 0x86, 0x3a, 0x44, 0xf4, 0xc6, 0x2e, 0x9f, 0xbb, 0xae, 0xcb, 0xff, 0x93,
+	0x82, 0x59, 0xde, 0xb4, 0xd1, 0x83, 0x89, 0xfe, 0x79, 0xef, 0x32, 0x2a, 0x0e, 0xb8, 0x4e, 0x03,
+	0x9d, 0x8c, 0x51, 0x72, 0x3a, 0x46, 0x12, 0xcc, 0xf1, 0xa1, 0x82, 0xa9, 0xdf, 0x3d, 0x83, 0x25,
+	0x7a, 0x0c, 0x69, 0x93, 0x50, 0x6c, 0xb0, 0xc1, 0xcc, 0x1b, 0x66, 0x76, 0x67, 0xeb, 0x8d, 0x27,
+	0xac, 0x06, 0x08, 0x75, 0x0c, 0x66, 0x93, 0xc9, 0xe9, 0x74, 0x30, 0xfd, 0x46, 0xb9, 0x9e, 0xe6,
+	0x10, 0x1e, 0xe9, 0x27, 0xb0, 0x4c, 0xb1, 0xa5, 0x13, 0x9b, 0xdf, 0x6a, 0xc7, 0x4c, 0xf3, 0x57,
+	0x63, 0x42, 0x21, 0xb8, 0x11, 0x52, 0x56, 0x21, 0x43, 0xb1, 0x81, 0xc9, 0x73, 0xbf, 0xf0, 0xa5,
+	0xf4, 0xd5, 0xb8, 0x16, 0x03, 0x14, 0x67, 0x79, 0x00, 0xb3, 0xa2, 0xdf, 0xc3, 0xd5, 0x6f, 0xa2,
+	0x02, 0x81, 0xf6, 0x20, 0xe5, 0x3f, 0x33, 0x16, 0xae, 0x75, 0x6d, 0xf1, 0xd1, 0xa8, 0x01, 0x0b,
+	0x4e, 0x1f, 0xdb, 0xc1, 0x9b, 0x65, 0xf1, 0x5a, 0x64, 0xc0, 0x28, 0xfc, 0x67, 0xca, 0x0a, 0xcc,
+	0x87, 0xe3, 0x3f, 0xc3, 0x33, 0x69, 0xee, 0x48, 0xcc, 0x7d, 0x54, 0x86, 0x34, 0x3e, 0xeb, 0x13,
+	0x8a, 0x35, 0xdd, 0xe3, 0x77, 0xe5, 0x85, 0x9d, 0xc2, 0x6b, 0xef, 0x82, 0x76, 0xf0, 0x40, 0x17,
+	0x0f, 0x83, 0x2f, 0xd8, 0xc3, 0x60, 0x5e, 0xc0, 0xca, 0x1e, 0x7a, 0x14, 0x96, 0x4f, 0x8e, 0x67,
+	0xd4, 0xbb, 0x6f, 0xcc, 0xa8, 0xc9, 0xe2, 0x41, 0x3f, 0x86, 0xb9, 0x8e, 0x43, 0x4f, 0x75, 0x6a,
+	0xf2, 0x5b, 0xef, 0xc2, 0xce, 0xfd, 0xcb, 0x18, 0x94, 0xdd, 0xca, 0x9e, 0x90, 0x56, 0x03, 0xd8,
+	0xc6, 0x2f, 0x63, 0x00, 0xe3, 0x7d, 0x56, 0x1f, 0xc6, 0xb1, 0x6e, 0xdb, 0x98, 0xf7, 0xb7, 0x98,
+	0x98, 0x49, 0xfe, 0x8e, 0x18, 0x94, 0x7e, 0xc8, 0xa9, 0x7f, 0xd7, 0x0b, 0xd7, 0xe8, 0x63, 0x98,
+	0x63, 0x3f, 0x47, 0x38, 0x03, 0xd1, 0xfb, 0xae, 0xf8, 0x4a, 0x0a, 0x30, 0x1b, 0x7f, 0x8c, 0x03,
+	0x28, 0x95, 0x72, 0xd0, 0x75, 0xdf, 0x7c, 0x10, 0x97, 0x49, 0xda, 0x06, 0xf6, 0xcb, 0x3e, 0x5c,
+	0xa3, 0x65, 0x98, 0x75, 0x4e, 0x6d, 0x4c, 0xfd, 0x4b, 0xa6, 0x58, 0xa0, 0x77, 0x20, 0x63, 0x38,
+	0xb6, 0x2d, 0x8a, 0x90, 0x71, 0x8a, 0x0e, 0xbc, 0x38, 0xde, 0x54, 0x4c, 0x54, 0x84, 0x45, 0xd6,
+	0x1e, 0x58, 0x2e, 0x68, 0x03, 0xda, 0xf3, 0x9b, 0x00, 0x6b, 0x19, 0xac, 0xd9, 0x1c, 0xd2, 0x5e,
+	0xb4, 0xb3, 0xa4, 0x26, 0x3a, 0x4b, 0x75, 0xaa, 0x15, 0x7e, 0xf7, 0xd2, 0x48, 0x84, 0x86, 0x4e,
+	0x05, 0x74, 0x15, 0xd2, 0xc1, 0xad, 0x96, 0x8d, 0xb1, 0x04, 0x33, 0xcc, 0x11, 0x57, 0x5a, 0x97,
+	0x19, 0x86, 0x29, 0x75, 0xa8, 0x78, 0x23, 0xab, 0x62, 0xb1, 0xf1, 0x73, 0x58, 0xac, 0xd7, 0xc5,
+	0xb5, 0xd7, 0x36, 0xf1, 0x59, 0xb4, 0x87, 0xc5, 0x26, 0x7b, 0x58, 0xe4, 0xec, 0xf1, 0x89, 0xb3,
+	0x4f, 0x68, 0x4d, 0x4c, 0x6a, 0xdd, 0xfa, 0x75, 0x0c, 0xe6, 0x83, 0xfb, 0x2a, 0xfb, 0xbd, 0xa7,
+	0xd9, 0x68, 0xd4, 0xb4, 0xf6, 0xd3, 0xa6, 0xac, 0x1d, 0x1e, 0xb4, 0x9a, 0x72, 0x45, 0xd9, 0x53,
+	0xe4, 0x6a, 0x7e, 0xa6, 0x70, 0x7b, 0x38, 0x2a, 0xde, 0x08, 0x04, 0x0f, 0x6d, 0xb7, 0x8f, 0x0d,
+	0xd2, 0x21, 0x98, 0xbf, 0x13, 0xc6, 0x98, 0xdd, 0x72, 0x4b, 0xa9, 0xe4, 0x63, 0x85, 0xa5, 0xe1,
+	0xa8, 0x98, 0x09, 0xa4, 0x77, 0x75, 0x97, 0x18, 0xec, 0xca, 0x3d, 0x96, 0x53, 0xcb, 0x07, 0xfb,
+	0x72, 0x35, 0x1f, 0x2f, 0xa0, 0xe1, 0xa8, 0x98, 0x0d, 0x04, 0x55, 0xdd, 0xee, 0x62, 0xb3, 0x90,
+	0xfc, 0xd5, 0xef, 0xd6, 0x66, 0xb6, 0xfe, 0x1c, 0x83, 0x74, 0x38, 0x08, 0xd8, 0xaf, 0x4a, 0x0d,
+	0xb5, 0x2a, 0xab, 0x17, 0x1d, 0x4d, 0x1a, 0x8e, 0x8a, 0xcb, 0xa1, 0x68, 0xf4, 0x6c, 0x9b, 0x90,
+	0x8f, 0xa0, 0x6a, 0x4a, 0x5d, 0x69, 0xe7, 0x63, 0x42, 0x67, 0x28, 0xcf, 0x7f, 0x5d, 0x40, 0x5b,
+	0xb0, 0x14, 0x91, 0xac, 0x97, 0xd5, 0x9f, 0xc8, 0xed, 0x7c, 0xbc, 0x70, 0x63, 0x38, 0x2a, 0xe6,
+	0x42, 0x51, 0xf1, 0x5b, 0x02, 0x7b, 0xa0, 0x44, 0x65, 0xeb, 0xf9, 0x44, 0x21, 0x37, 0x1c, 0x15,
+	0x17, 0xc6, 0x72, 0x75, 0xdf, 0x86, 0x3f, 0xc5, 0x20, 0x3b, 0x39, 0x2a, 0xd0, 0x43, 0x58, 0x15,
+	0xe0, 0xaa, 0xa2, 0xca, 0x95, 0xb6, 0xd2, 0x38, 0x98, 0xb2, 0xe6, 0xee, 0x70, 0x54, 0x5c, 0x99,
+	0x04, 0x45, 0x4d, 0x2a, 0xc1, 0x8d, 0x69, 0xfc, 0xee, 0xe1, 0xd3, 0x7c, 0xac, 0x70, 0x73, 0x38,
+	0x2a, 0x2e, 0x4d, 0xe2, 0x76, 0x07, 0xe7, 0xe8, 0x3d, 0x58, 0x9e, 0x96, 0x6f, 0xc9, 0xb5, 0x5a,
+	0x3e, 0x5e, 0xb8, 0x35, 0x1c, 0x15, 0xd1, 0x24, 0xa0, 0x85, 0x7b, 0x3d, 0xff, 0xe8, 0xbf, 0x88,
+	0x43, 0x66, 0x22, 0x89, 0xd1, 0x47, 0x50, 0x50, 0xe5, 0x27, 0x87, 0x72, 0xab, 0xad, 0xb5, 0xda,
+	0xe5, 0xf6, 0x61, 0x6b, 0xea, 0xe0, 0x77, 0x86, 0xa3, 0xa2, 0x34, 0x01, 0x89, 0x9e, 0xfb, 0x63,
+	0x58, 0x9d, 0x42, 0x1f, 0x34, 0xda, 0x9a, 0xfc, 0xa9, 0x5c, 0x39, 0x6c, 0xcb, 0xd5, 0x7c, 0xec,
+	0x02, 0xf8, 0x81, 0xe3, 0xc9, 0x67, 0xd8, 0x18, 0x78, 0xd8, 0x44, 0x1f, 0x82, 0x34, 0x05, 0x6f,
+	0x1d, 0x56, 0x2a, 0xb2, 0x5c, 0xe5, 0x59, 0x54, 0x18, 0x8e, 0x8a, 0xb7, 0x26, 0xb0, 0xad, 0x81,
+	0x61, 0x60, 0x6c, 0x62, 0x93, 0xe5, 0xf4, 0x14, 0x72, 0xaf, 0xac, 0xd4, 0xe4, 0x6a, 0x3e, 0x21,
+	0x72, 0x7a, 0x02, 0xb6, 0xa7, 0x93, 0x5e, 0x98, 0x81, 0xbf, 0x4d, 0xc0, 0x42, 0xa4, 0x2d, 0xb3,
+	0x33, 0x08, 0x57, 0x5e, 0x68, 0x3e, 0x3f, 0x43, 0x44, 0x3c, 0x6a, 0xfc, 0x03, 0x58, 0x99, 0x40,
+	0x4e, 0x99, 0x3e, 0x0d, 0x8d, 0x1a, 0xfe, 0x01, 0x48, 0xaf, 0x41, 0xeb, 0xe5, 0x76, 0xe5, 0x31,
+	0x37, 0x7c, 0x65, 0x38, 0x2a, 0xde, 0x9c, 0x44, 0xd6, 0xd9, 0x00, 0xc3, 0x26, 0xaa, 0xc0, 0xda,
+	0x04, 0xb0, 0x59, 0x56, 0xdb, 0x4a, 0xb9, 0x56, 0x7b, 0x1a, 0xc2, 0x13, 0x85, 0xf5, 0xe1, 0xa8,
+	0xb8, 0x1a, 0x81, 0x37, 0x75, 0xca, 0x7e, 0xcb, 0xeb, 0x9d, 0x07, 0x24, 0x61, 0xd9, 0xf9, 0x24,
+	0x95, 0x46, 0xbd, 0x59, 0x93, 0xd9, 0xa9, 0x93, 0x91, 0xb2, 0x13, 0xe0, 0x8a, 0x63, 0xf5, 0x7b,
+	0xd8, 0x13, 0x2e, 0x9f, 0x44, 0x95, 0x0f, 0x2a, 0x32, 0x73, 0xf9, 0xac, 0x70, 0x79, 0x14, 0xa4,
+	0xdb, 0x06, 0xee, 0x61, 0x73, 0x9c, 0xa7, 0x3e, 0x46, 0xfe, 0xb4, 0xa9, 0xa8, 0x72, 0x35, 0x9f,
+	0x8a, 0xe4, 0xa9, 0x80, 0xc8, 0x7c, 0xbe, 0x06, 0x41, 0xfa, 0x4b, 0x1c, 0xf2, 0xd3, 0xfd, 0x96,
+	0xd9, 0xae, 0x54, 0xca, 0xda, 0xa5, 0xe9, 0xca, 0x6d, 0x9f, 0x46, 0x46, 0x83, 0xf6, 0x23, 0x28,
+	0x5c, 0x40, 0xd2, 0x94, 0x0f, 0xaa, 0xca, 0xc1, 0x7e, 0x3e, 0x56, 0x58, 0x1d, 0x8e, 0x8a, 0xb7,
+	0xa7, 0x09, 0x9a, 0xd8, 0x36, 0x89, 0xdd, 0x45, 0x8f, 0xe0, 0xce, 0x05, 0xe0, 0x68, 0xce, 0xf2,
+	0x3a, 0x9f, 0x86, 0x8f, 0xd3, 0xf6, 0x01, 0xac, 0x5c, 0x40, 0x10, 0xa6, 0x2e, 0x4f, 0x99, 0x69,
+	0xb4, 0xc8, 0x5e, 0xf4, 0xf0, 0x42, 0xdd, 0x6d, 0xa5, 0x2e, 0x57, 0xb5, 0xc6, 0x61, 0x3b, 0x9f,
+	0x14, 0xb5, 0x36, 0x8d, 0x66, 0x97, 0x19, 0xb3, 0x31, 0xf0, 0x84, 0x63, 0x77, 0x1f, 0xbf, 0xf8,
+	0xe7, 0xda, 0xcc, 0x8b, 0x97, 0x6b, 0xb1, 0x2f, 0x5f, 0xae, 0xc5, 0xfe, 0xf1, 0x72, 0x2d, 0xf6,
+	0xc5, 0xab, 0xb5, 0x99, 0x2f, 0x5f, 0xad, 0xcd, 0xfc, 0xf5, 0xd5, 0xda, 0xcc, 0x67, 0x5b, 0x91,
+	0xdb, 0xd6, 0x33, 0xdd, 0xd5, 0x07, 0x74, 0xdb, 0x3d, 0x76, 0xba, 0x03, 0x7b, 0xfb, 0x2c, 0xf2,
+	0x7f, 0x27, 0xfc, 0xd6, 0x75, 0x94, 0xe2, 0x37, 0x84, 0xef, 0xff, 0x77, 0x00, 0xeb, 0xbe, 0xcf,
+	0x32, 0x5e, 0x19, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -737,6 +918,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinFeePoolReserve.Size()
+		i -= size
+		if _, err := m.MinFeePoolReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeDenoms[iNdEx])
+			copy(dAtA[i:], m.FeeDenoms[iNdEx])
+			i = encodeVarintLiquidity(dAtA, i, uint64(len(m.FeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.FinishedRecordRetentionBlocks != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.FinishedRecordRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if m.MaxNumActivePoolsPerPair != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxNumActivePoolsPerPair))
 		i--
@@ -1235,17 +1446,31 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Forward != nil {
+		{
+			size, err := m.Forward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.Status != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
 		i--
 		dAtA[i] = 0x78
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintLiquidity(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintLiquidity(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x72
 	if m.BatchId != 0 {
@@ -1348,6 +1573,142 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IBCForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintLiquidity(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ICARequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICARequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.OrderIds) > 0 {
+		dAtA11 := make([]byte, len(m.OrderIds)*10)
+		var j10 int
+		for _, num := range m.OrderIds {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintLiquidity(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MMOrderIndex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1369,20 +1730,20 @@ func (m *MMOrderIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.OrderIds) > 0 {
-		dAtA9 := make([]byte, len(m.OrderIds)*10)
-		var j8 int
+		dAtA13 := make([]byte, len(m.OrderIds)*10)
+		var j12 int
 		for _, num := range m.OrderIds {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j12++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA13[j12] = uint8(num)
+			j12++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintLiquidity(dAtA, i, uint64(j8))
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintLiquidity(dAtA, i, uint64(j12))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1471,6 +1832,17 @@ func (m *Params) Size() (n int) {
 	if m.MaxNumActivePoolsPerPair != 0 {
 		n += 2 + sovLiquidity(uint64(m.MaxNumActivePoolsPerPair))
 	}
+	if m.FinishedRecordRetentionBlocks != 0 {
+		n += 2 + sovLiquidity(uint64(m.FinishedRecordRetentionBlocks))
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, s := range m.FeeDenoms {
+			l = len(s)
+			n += 2 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.MinFeePoolReserve.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
 	return n
 }
 
@@ -1672,6 +2044,74 @@ func (m *Order) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovLiquidity(uint64(m.Status))
 	}
+	if m.Forward != nil {
+		l = m.Forward.Size()
+		n += 2 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func (m *IBCForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *ICARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovLiquidity(uint64(m.Sequence))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	if len(m.OrderIds) > 0 {
+		l = 0
+		for _, e := range m.OrderIds {
+			l += sovLiquidity(uint64(e))
+		}
+		n += 1 + sovLiquidity(uint64(l)) + l
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
 	return n
 }
 
@@ -2201,27 +2641,112 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidity(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedRecordRetentionBlocks", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidity
+			m.FinishedRecordRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishedRecordRetentionBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePoolReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeePoolReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Pair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3706,6 +4231,532 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Forward == nil {
+				m.Forward = &IBCForward{}
+			}
+			if err := m.Forward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICARequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICARequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICARequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ICARequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OrderIds = append(m.OrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OrderIds) == 0 {
+					m.OrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLiquidity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OrderIds = append(m.OrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -1,11 +1,13 @@
 package types
 
 import (
+	"fmt"
 	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 
 	"shogun/x/liquidity/amm"
 )
@@ -22,6 +24,10 @@ var (
 	_ sdk.Msg = (*MsgCancelOrder)(nil)
 	_ sdk.Msg = (*MsgCancelAllOrders)(nil)
 	_ sdk.Msg = (*MsgCancelMMOrder)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgRegisterICA)(nil)
+	_ sdk.Msg = (*MsgICAMMOrder)(nil)
+	_ sdk.Msg = (*MsgICACancelMMOrder)(nil)
 )
 
 // Message types for the liquidity module
@@ -37,6 +43,10 @@ const (
 	TypeMsgCancelOrder      = "cancel_order"
 	TypeMsgCancelAllOrders  = "cancel_all_orders"
 	TypeMsgCancelMMOrder    = "cancel_mm_order"
+	TypeMsgUpdateParams     = "update_params"
+	TypeMsgRegisterICA      = "register_ica"
+	TypeMsgICAMMOrder       = "ica_mm_order"
+	TypeMsgICACancelMMOrder = "ica_cancel_mm_order"
 )
 
 // NewMsgCreatePair returns a new MsgCreatePair.
@@ -393,6 +403,11 @@ func (msg MsgLimitOrder) ValidateBasic() error {
 	if msg.OrderLifespan < 0 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must not be negative: %s", msg.OrderLifespan)
 	}
+	if msg.Forward != nil {
+		if err := msg.Forward.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid forward: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -475,6 +490,11 @@ func (msg MsgMarketOrder) ValidateBasic() error {
 	if msg.OrderLifespan < 0 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must not be negative: %s", msg.OrderLifespan)
 	}
+	if msg.Forward != nil {
+		if err := msg.Forward.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid forward: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -733,3 +753,229 @@ func (msg MsgCancelMMOrder) GetOrderer() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgUpdateParams creates a new MsgUpdateParams.
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %v", err)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRegisterICA creates a new MsgRegisterICA.
+func NewMsgRegisterICA(owner sdk.AccAddress, connectionId, version string) *MsgRegisterICA {
+	return &MsgRegisterICA{
+		Owner:        owner.String(),
+		ConnectionId: connectionId,
+		Version:      version,
+	}
+}
+
+func (msg MsgRegisterICA) Route() string { return RouterKey }
+
+func (msg MsgRegisterICA) Type() string { return TypeMsgRegisterICA }
+
+func (msg MsgRegisterICA) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %v", err)
+	}
+	if err := validateConnectionId(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgRegisterICA) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRegisterICA) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRegisterICA) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgICAMMOrder creates a new MsgICAMMOrder.
+func NewMsgICAMMOrder(
+	owner sdk.AccAddress,
+	connectionId string,
+	pairId uint64,
+	maxSellPrice, minSellPrice math.LegacyDec, sellAmt math.Int,
+	maxBuyPrice, minBuyPrice math.LegacyDec, buyAmt math.Int,
+	orderLifespan, timeout time.Duration,
+) *MsgICAMMOrder {
+	return &MsgICAMMOrder{
+		Owner:         owner.String(),
+		ConnectionId:  connectionId,
+		PairId:        pairId,
+		MaxSellPrice:  maxSellPrice,
+		MinSellPrice:  minSellPrice,
+		SellAmount:    sellAmt,
+		MaxBuyPrice:   maxBuyPrice,
+		MinBuyPrice:   minBuyPrice,
+		BuyAmount:     buyAmt,
+		OrderLifespan: orderLifespan,
+		Timeout:       timeout,
+	}
+}
+
+func (msg MsgICAMMOrder) Route() string { return RouterKey }
+
+func (msg MsgICAMMOrder) Type() string { return TypeMsgICAMMOrder }
+
+func (msg MsgICAMMOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %v", err)
+	}
+	if err := validateConnectionId(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// The orderer of the MM order is the interchain account, which isn't known
+	// here, so the owner stands in for it.
+	if err := msg.MMOrderMsg(msg.Owner).ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.Timeout <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "timeout must be positive: %s", msg.Timeout)
+	}
+	return nil
+}
+
+func (msg MsgICAMMOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgICAMMOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgICAMMOrder) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// MMOrderMsg returns the MsgMMOrder executed on the host chain by the
+// interchain account.
+func (msg MsgICAMMOrder) MMOrderMsg(orderer string) *MsgMMOrder {
+	return &MsgMMOrder{
+		Orderer:       orderer,
+		PairId:        msg.PairId,
+		MaxSellPrice:  msg.MaxSellPrice,
+		MinSellPrice:  msg.MinSellPrice,
+		SellAmount:    msg.SellAmount,
+		MaxBuyPrice:   msg.MaxBuyPrice,
+		MinBuyPrice:   msg.MinBuyPrice,
+		BuyAmount:     msg.BuyAmount,
+		OrderLifespan: msg.OrderLifespan,
+	}
+}
+
+// NewMsgICACancelMMOrder creates a new MsgICACancelMMOrder.
+func NewMsgICACancelMMOrder(owner sdk.AccAddress, connectionId string, pairId uint64, timeout time.Duration) *MsgICACancelMMOrder {
+	return &MsgICACancelMMOrder{
+		Owner:        owner.String(),
+		ConnectionId: connectionId,
+		PairId:       pairId,
+		Timeout:      timeout,
+	}
+}
+
+func (msg MsgICACancelMMOrder) Route() string { return RouterKey }
+
+func (msg MsgICACancelMMOrder) Type() string { return TypeMsgICACancelMMOrder }
+
+func (msg MsgICACancelMMOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %v", err)
+	}
+	if err := validateConnectionId(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if msg.Timeout <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "timeout must be positive: %s", msg.Timeout)
+	}
+	return nil
+}
+
+func (msg MsgICACancelMMOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgICACancelMMOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgICACancelMMOrder) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// CancelMMOrderMsg returns the MsgCancelMMOrder executed on the host chain by
+// the interchain account.
+func (msg MsgICACancelMMOrder) CancelMMOrderMsg(orderer string) *MsgCancelMMOrder {
+	return &MsgCancelMMOrder{
+		Orderer: orderer,
+		PairId:  msg.PairId,
+	}
+}
+
+func validateConnectionId(connectionId string) error {
+	if err := host.ConnectionIdentifierValidator(connectionId); err != nil {
+		// The validator's errors carry stack traces when formatted with %v.
+		return fmt.Errorf("invalid connection id: %s", err.Error())
+	}
+	return nil
+}
@@ -470,6 +470,34 @@ func TestMsgLimitOrder(t *testing.T) {
 			},
 			"order lifespan must not be negative: -1ns: invalid request",
 		},
+		{
+			"valid forward",
+			func(msg *types.MsgLimitOrder) {
+				msg.Forward = &types.IBCForward{ChannelId: "channel-0", Receiver: "cosmos1receiver", Timeout: time.Hour}
+			},
+			"",
+		},
+		{
+			"invalid forward channel",
+			func(msg *types.MsgLimitOrder) {
+				msg.Forward = &types.IBCForward{ChannelId: "ch", Receiver: "cosmos1receiver", Timeout: time.Hour}
+			},
+			"invalid forward: invalid channel id: identifier ch has invalid length: 2, must be between 8-64 characters: invalid identifier: invalid request",
+		},
+		{
+			"empty forward receiver",
+			func(msg *types.MsgLimitOrder) {
+				msg.Forward = &types.IBCForward{ChannelId: "channel-0", Timeout: time.Hour}
+			},
+			"invalid forward: receiver must not be empty: invalid request",
+		},
+		{
+			"zero forward timeout",
+			func(msg *types.MsgLimitOrder) {
+				msg.Forward = &types.IBCForward{ChannelId: "channel-0", Receiver: "cosmos1receiver"}
+			},
+			"invalid forward: timeout must be positive: 0s: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.NewMsgLimitOrder(
@@ -574,6 +602,34 @@ func TestMsgMarketOrder(t *testing.T) {
 			},
 			"order lifespan must not be negative: -1ns: invalid request",
 		},
+		{
+			"valid forward",
+			func(msg *types.MsgMarketOrder) {
+				msg.Forward = &types.IBCForward{ChannelId: "channel-0", Receiver: "cosmos1receiver", Timeout: time.Hour}
+			},
+			"",
+		},
+		{
+			"invalid forward channel",
+			func(msg *types.MsgMarketOrder) {
+				msg.Forward = &types.IBCForward{ChannelId: "ch", Receiver: "cosmos1receiver", Timeout: time.Hour}
+			},
+			"invalid forward: invalid channel id: identifier ch has invalid length: 2, must be between 8-64 characters: invalid identifier: invalid request",
+		},
+		{
+			"empty forward receiver",
+			func(msg *types.MsgMarketOrder) {
+				msg.Forward = &types.IBCForward{ChannelId: "channel-0", Timeout: time.Hour}
+			},
+			"invalid forward: receiver must not be empty: invalid request",
+		},
+		{
+			"zero forward timeout",
+			func(msg *types.MsgMarketOrder) {
+				msg.Forward = &types.IBCForward{ChannelId: "channel-0", Receiver: "cosmos1receiver"}
+			},
+			"invalid forward: timeout must be positive: 0s: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.NewMsgMarketOrder(
@@ -883,3 +939,132 @@ func TestMsgCancelMMOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgICAMMOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgICAMMOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgICAMMOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid owner",
+			func(msg *types.MsgICAMMOrder) {
+				msg.Owner = "invalidaddr"
+			},
+			"invalid owner address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid connection id",
+			func(msg *types.MsgICAMMOrder) {
+				msg.ConnectionId = ""
+			},
+			"invalid connection id: identifier cannot be blank: invalid identifier: invalid request",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgICAMMOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid mm order",
+			func(msg *types.MsgICAMMOrder) {
+				msg.SellAmount = math.ZeroInt()
+				msg.BuyAmount = math.ZeroInt()
+			},
+			"sell amount and buy amount must not be zero at the same time: invalid request",
+		},
+		{
+			"zero timeout",
+			func(msg *types.MsgICAMMOrder) {
+				msg.Timeout = 0
+			},
+			"timeout must be positive: 0s: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgICAMMOrder(
+				testAddr, "connection-0", 1,
+				utils.ParseDec("1.1"), utils.ParseDec("1.05"), math.NewInt(10000),
+				utils.ParseDec("0.95"), utils.ParseDec("0.9"), math.NewInt(10000),
+				time.Hour, 10*time.Minute)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgICAMMOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOwner(), signers[0])
+				require.Equal(t, "orderer", msg.MMOrderMsg("orderer").Orderer)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgICACancelMMOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgICACancelMMOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgICACancelMMOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid owner",
+			func(msg *types.MsgICACancelMMOrder) {
+				msg.Owner = "invalidaddr"
+			},
+			"invalid owner address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid connection id",
+			func(msg *types.MsgICACancelMMOrder) {
+				msg.ConnectionId = ""
+			},
+			"invalid connection id: identifier cannot be blank: invalid identifier: invalid request",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgICACancelMMOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"zero timeout",
+			func(msg *types.MsgICACancelMMOrder) {
+				msg.Timeout = 0
+			},
+			"timeout must be positive: 0s: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgICACancelMMOrder(testAddr, "connection-0", 1, 10*time.Minute)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgICACancelMMOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOwner(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -23,6 +23,21 @@ func OrderDirectionFromAMM(dir amm.OrderDirection) OrderDirection {
 	}
 }
 
+// PriceDirectionFromAMM converts amm.PriceDirection to liquidity module's
+// PriceDirection.
+func PriceDirectionFromAMM(dir amm.PriceDirection) PriceDirection {
+	switch dir {
+	case amm.PriceStaying:
+		return PriceDirectionStaying
+	case amm.PriceIncreasing:
+		return PriceDirectionIncreasing
+	case amm.PriceDecreasing:
+		return PriceDirectionDecreasing
+	default:
+		panic(fmt.Errorf("invalid price direction: %s", dir))
+	}
+}
+
 type UserOrder struct {
 	*amm.BaseOrder
 	Orderer                         sdk.AccAddress
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"io"
 	"sort"
 
 	"cosmossdk.io/math"
@@ -136,19 +137,20 @@ func MakeOrderBookPairResponse(pairId uint64, ov *amm.OrderBookView, lowestPrice
 	return resp
 }
 
-// PrintOrderBookResponse prints out OrderBookResponse in human-readable form.
-func PrintOrderBookResponse(ob OrderBookResponse, basePrice math.LegacyDec) {
-	fmt.Println("+------------------------------------------------------------------------+")
+// PrintOrderBookResponse prints out OrderBookResponse in human-readable form
+// to w.
+func PrintOrderBookResponse(w io.Writer, ob OrderBookResponse, basePrice math.LegacyDec) {
+	fmt.Fprintln(w, "+------------------------------------------------------------------------+")
 	for _, tick := range ob.Sells {
-		fmt.Printf("| %18s | %28s |                    |\n", tick.UserOrderAmount, tick.Price.String())
+		fmt.Fprintf(w, "| %18s | %28s |                    |\n", tick.UserOrderAmount, tick.Price.String())
 	}
-	fmt.Println("|------------------------------------------------------------------------|")
-	fmt.Printf("|                      %28s                      |\n", basePrice.String())
-	fmt.Println("|------------------------------------------------------------------------|")
+	fmt.Fprintln(w, "|------------------------------------------------------------------------|")
+	fmt.Fprintf(w, "|                      %28s                      |\n", basePrice.String())
+	fmt.Fprintln(w, "|------------------------------------------------------------------------|")
 	for _, tick := range ob.Buys {
-		fmt.Printf("|                    | %28s | %-18s |\n", tick.Price.String(), tick.UserOrderAmount)
+		fmt.Fprintf(w, "|                    | %28s | %-18s |\n", tick.Price.String(), tick.UserOrderAmount)
 	}
-	fmt.Println("+------------------------------------------------------------------------+")
+	fmt.Fprintln(w, "+------------------------------------------------------------------------+")
 }
 
 // FitPriceToTickGap fits price into given tick gap.
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"math/rand"
+	"os"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -34,7 +35,7 @@ func TestMakeOrderBookResponse(t *testing.T) {
 		PriceUnitPower: 0,
 		MaxNumTicks:    20,
 	})
-	types.PrintOrderBookResponse(resp.OrderBooks[0], basePrice)
+	types.PrintOrderBookResponse(os.Stdout, resp.OrderBooks[0], basePrice)
 }
 
 func BenchmarkMakeOrderBookResponse(b *testing.B) {
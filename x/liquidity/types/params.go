@@ -11,11 +11,12 @@ import (
 
 // Liquidity params default values
 const (
-	DefaultBatchSize                    uint32 = 1
-	DefaultTickPrecision                uint32 = 4
-	DefaultMaxNumMarketMakingOrderTicks        = 10
-	DefaultMaxOrderLifespan                    = 24 * time.Hour
-	DefaultMaxNumActivePoolsPerPair            = 20
+	DefaultBatchSize                     uint32 = 1
+	DefaultTickPrecision                 uint32 = 4
+	DefaultMaxNumMarketMakingOrderTicks         = 10
+	DefaultMaxOrderLifespan                     = 24 * time.Hour
+	DefaultMaxNumActivePoolsPerPair             = 20
+	DefaultFinishedRecordRetentionBlocks        = 0
 )
 
 // Liquidity params default values
@@ -32,6 +33,8 @@ var (
 	DefaultDepositExtraGas          = sdk.Gas(60000)
 	DefaultWithdrawExtraGas         = sdk.Gas(64000)
 	DefaultOrderExtraGas            = sdk.Gas(37000)
+	DefaultFeeDenoms                = []string(nil)
+	DefaultMinFeePoolReserve        = math.NewInt(1_000_000_000_000)
 )
 
 // General constants
@@ -44,26 +47,32 @@ const (
 var (
 	// GlobalEscrowAddress is an escrow for deposit/withdraw requests.
 	GlobalEscrowAddress = DeriveAddress(AddressType32Bytes, ModuleName, "GlobalEscrow")
+	// FeeAbstractionAddress holds the transaction fees paid in non-native
+	// coins until they are converted to the native coin.
+	FeeAbstractionAddress = DeriveAddress(AddressType32Bytes, ModuleName, "FeeAbstraction")
 )
 
 var (
-	KeyBatchSize                    = []byte("BatchSize")
-	KeyTickPrecision                = []byte("TickPrecision")
-	KeyFeeCollectorAddress          = []byte("FeeCollectorAddress")
-	KeyDustCollectorAddress         = []byte("DustCollectorAddress")
-	KeyMinInitialPoolCoinSupply     = []byte("MinInitialPoolCoinSupply")
-	KeyPairCreationFee              = []byte("PairCreationFee")
-	KeyPoolCreationFee              = []byte("PoolCreationFee")
-	KeyMinInitialDepositAmount      = []byte("MinInitialDepositAmount")
-	KeyMaxPriceLimitRatio           = []byte("MaxPriceLimitRatio")
-	KeyMaxNumMarketMakingOrderTicks = []byte("MaxNumMarketMakingOrderTicks")
-	KeyMaxOrderLifespan             = []byte("MaxOrderLifespan")
-	KeySwapFeeRate                  = []byte("SwapFeeRate")
-	KeyWithdrawFeeRate              = []byte("WithdrawFeeRate")
-	KeyDepositExtraGas              = []byte("DepositExtraGas")
-	KeyWithdrawExtraGas             = []byte("WithdrawExtraGas")
-	KeyOrderExtraGas                = []byte("OrderExtraGas")
-	KeyMaxNumActivePoolsPerPair     = []byte("MaxNumActivePoolsPerPair")
+	KeyBatchSize                     = []byte("BatchSize")
+	KeyTickPrecision                 = []byte("TickPrecision")
+	KeyFeeCollectorAddress           = []byte("FeeCollectorAddress")
+	KeyDustCollectorAddress          = []byte("DustCollectorAddress")
+	KeyMinInitialPoolCoinSupply      = []byte("MinInitialPoolCoinSupply")
+	KeyPairCreationFee               = []byte("PairCreationFee")
+	KeyPoolCreationFee               = []byte("PoolCreationFee")
+	KeyMinInitialDepositAmount       = []byte("MinInitialDepositAmount")
+	KeyMaxPriceLimitRatio            = []byte("MaxPriceLimitRatio")
+	KeyMaxNumMarketMakingOrderTicks  = []byte("MaxNumMarketMakingOrderTicks")
+	KeyMaxOrderLifespan              = []byte("MaxOrderLifespan")
+	KeySwapFeeRate                   = []byte("SwapFeeRate")
+	KeyWithdrawFeeRate               = []byte("WithdrawFeeRate")
+	KeyDepositExtraGas               = []byte("DepositExtraGas")
+	KeyWithdrawExtraGas              = []byte("WithdrawExtraGas")
+	KeyOrderExtraGas                 = []byte("OrderExtraGas")
+	KeyMaxNumActivePoolsPerPair      = []byte("MaxNumActivePoolsPerPair")
+	KeyFinishedRecordRetentionBlocks = []byte("FinishedRecordRetentionBlocks")
+	KeyFeeDenoms                     = []byte("FeeDenoms")
+	KeyMinFeePoolReserve             = []byte("MinFeePoolReserve")
 )
 
 var _ paramstypes.ParamSet = (*Params)(nil)
@@ -75,23 +84,26 @@ func ParamKeyTable() paramstypes.KeyTable {
 // DefaultParams returns a default params for the liquidity module.
 func DefaultParams() Params {
 	return Params{
-		BatchSize:                    DefaultBatchSize,
-		TickPrecision:                DefaultTickPrecision,
-		FeeCollectorAddress:          DefaultFeeCollectorAddress.String(),
-		DustCollectorAddress:         DefaultDustCollectorAddress.String(),
-		MinInitialPoolCoinSupply:     DefaultMinInitialPoolCoinSupply,
-		PairCreationFee:              DefaultPairCreationFee,
-		PoolCreationFee:              DefaultPoolCreationFee,
-		MinInitialDepositAmount:      DefaultMinInitialDepositAmount,
-		MaxPriceLimitRatio:           DefaultMaxPriceLimitRatio,
-		MaxNumMarketMakingOrderTicks: DefaultMaxNumMarketMakingOrderTicks,
-		MaxOrderLifespan:             DefaultMaxOrderLifespan,
-		SwapFeeRate:                  DefaultSwapFeeRate,
-		WithdrawFeeRate:              DefaultWithdrawFeeRate,
-		DepositExtraGas:              DefaultDepositExtraGas,
-		WithdrawExtraGas:             DefaultWithdrawExtraGas,
-		OrderExtraGas:                DefaultOrderExtraGas,
-		MaxNumActivePoolsPerPair:     DefaultMaxNumActivePoolsPerPair,
+		BatchSize:                     DefaultBatchSize,
+		TickPrecision:                 DefaultTickPrecision,
+		FeeCollectorAddress:           DefaultFeeCollectorAddress.String(),
+		DustCollectorAddress:          DefaultDustCollectorAddress.String(),
+		MinInitialPoolCoinSupply:      DefaultMinInitialPoolCoinSupply,
+		PairCreationFee:               DefaultPairCreationFee,
+		PoolCreationFee:               DefaultPoolCreationFee,
+		MinInitialDepositAmount:       DefaultMinInitialDepositAmount,
+		MaxPriceLimitRatio:            DefaultMaxPriceLimitRatio,
+		MaxNumMarketMakingOrderTicks:  DefaultMaxNumMarketMakingOrderTicks,
+		MaxOrderLifespan:              DefaultMaxOrderLifespan,
+		SwapFeeRate:                   DefaultSwapFeeRate,
+		WithdrawFeeRate:               DefaultWithdrawFeeRate,
+		DepositExtraGas:               DefaultDepositExtraGas,
+		WithdrawExtraGas:              DefaultWithdrawExtraGas,
+		OrderExtraGas:                 DefaultOrderExtraGas,
+		MaxNumActivePoolsPerPair:      DefaultMaxNumActivePoolsPerPair,
+		FinishedRecordRetentionBlocks: DefaultFinishedRecordRetentionBlocks,
+		FeeDenoms:                     DefaultFeeDenoms,
+		MinFeePoolReserve:             DefaultMinFeePoolReserve,
 	}
 }
 
@@ -115,6 +127,9 @@ func (params *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyWithdrawExtraGas, &params.WithdrawExtraGas, validateExtraGas),
 		paramstypes.NewParamSetPair(KeyOrderExtraGas, &params.OrderExtraGas, validateExtraGas),
 		paramstypes.NewParamSetPair(KeyMaxNumActivePoolsPerPair, &params.MaxNumActivePoolsPerPair, validateMaxNumActivePoolsPerPair),
+		paramstypes.NewParamSetPair(KeyFinishedRecordRetentionBlocks, &params.FinishedRecordRetentionBlocks, validateFinishedRecordRetentionBlocks),
+		paramstypes.NewParamSetPair(KeyFeeDenoms, &params.FeeDenoms, validateFeeDenoms),
+		paramstypes.NewParamSetPair(KeyMinFeePoolReserve, &params.MinFeePoolReserve, validateMinFeePoolReserve),
 	}
 }
 
@@ -141,6 +156,9 @@ func (params Params) Validate() error {
 		{params.WithdrawExtraGas, validateExtraGas},
 		{params.OrderExtraGas, validateExtraGas},
 		{params.MaxNumActivePoolsPerPair, validateMaxNumActivePoolsPerPair},
+		{params.FinishedRecordRetentionBlocks, validateFinishedRecordRetentionBlocks},
+		{params.FeeDenoms, validateFeeDenoms},
+		{params.MinFeePoolReserve, validateMinFeePoolReserve},
 	} {
 		if err := field.validateFunc(field.val); err != nil {
 			return err
@@ -334,3 +352,48 @@ func validateMaxNumActivePoolsPerPair(i interface{}) error {
 	}
 	return nil
 }
+
+func validateFinishedRecordRetentionBlocks(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateFeeDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denomSet := map[string]struct{}{}
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid fee denom: %w", err)
+		}
+		if _, ok := denomSet[denom]; ok {
+			return fmt.Errorf("duplicate fee denom: %s", denom)
+		}
+		denomSet[denom] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateMinFeePoolReserve(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min fee pool reserve must not be nil")
+	}
+
+	if !v.IsPositive() {
+		return fmt.Errorf("min fee pool reserve must be positive: %s", v)
+	}
+
+	return nil
+}
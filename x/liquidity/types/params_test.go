@@ -113,6 +113,27 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"withdraw fee rate must not be negative: -1.000000000000000000",
 		},
+		{
+			"invalid FeeDenoms",
+			func(params *types.Params) {
+				params.FeeDenoms = []string{"!"}
+			},
+			"invalid fee denom: invalid denom: !",
+		},
+		{
+			"duplicate FeeDenoms",
+			func(params *types.Params) {
+				params.FeeDenoms = []string{"denom1", "denom1"}
+			},
+			"duplicate fee denom: denom1",
+		},
+		{
+			"zero MinFeePoolReserve",
+			func(params *types.Params) {
+				params.MinFeePoolReserve = sdk.ZeroInt()
+			},
+			"min fee pool reserve must be positive: 0",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
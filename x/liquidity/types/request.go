@@ -2,11 +2,13 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 )
 
 // NewDepositRequest returns a new DepositRequest.
@@ -154,6 +156,7 @@ func NewOrderForLimitOrder(msg *MsgLimitOrder, id uint64, pair Pair, offerCoin s
 		BatchId:            pair.CurrentBatchId,
 		ExpireAt:           expireAt,
 		Status:             OrderStatusNotExecuted,
+		Forward:            msg.Forward,
 	}
 }
 
@@ -175,6 +178,7 @@ func NewOrderForMarketOrder(msg *MsgMarketOrder, id uint64, pair Pair, offerCoin
 		BatchId:            pair.CurrentBatchId,
 		ExpireAt:           expireAt,
 		Status:             OrderStatusNotExecuted,
+		Forward:            msg.Forward,
 	}
 }
 
@@ -269,6 +273,11 @@ func (order Order) Validate() error {
 	if !order.Status.IsValid() {
 		return fmt.Errorf("invalid status: %s", order.Status)
 	}
+	if order.Forward != nil {
+		if err := order.Forward.Validate(); err != nil {
+			return fmt.Errorf("invalid forward: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -277,6 +286,21 @@ func (order Order) ExpiredAt(t time.Time) bool {
 	return !order.ExpireAt.After(t)
 }
 
+// Validate validates IBCForward.
+func (fwd IBCForward) Validate() error {
+	if err := host.ChannelIdentifierValidator(fwd.ChannelId); err != nil {
+		// Error() is used since the host errors are printed with stack traces.
+		return fmt.Errorf("invalid channel id: %s", err.Error())
+	}
+	if strings.TrimSpace(fwd.Receiver) == "" {
+		return fmt.Errorf("receiver must not be empty")
+	}
+	if fwd.Timeout <= 0 {
+		return fmt.Errorf("timeout must be positive: %s", fwd.Timeout)
+	}
+	return nil
+}
+
 // SetStatus sets the order's status.
 // SetStatus is to easily find locations where the status is changed.
 func (order *Order) SetStatus(status OrderStatus) {
@@ -0,0 +1,968 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crescent/liquidity/v1beta1/stream.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeOrderBooksRequest is the request type for the
+// Stream/SubscribeOrderBooks RPC method.
+type SubscribeOrderBooksRequest struct {
+	// pair_ids specifies the pairs to subscribe to, which are at most 5.
+	PairIds []uint64 `protobuf:"varint,1,rep,packed,name=pair_ids,json=pairIds,proto3" json:"pair_ids,omitempty"`
+	// num_ticks specifies the max number of ticks on each side of the order
+	// books, which is at most 20. Defaults to 20.
+	NumTicks uint32 `protobuf:"varint,2,opt,name=num_ticks,json=numTicks,proto3" json:"num_ticks,omitempty"`
+}
+
+func (m *SubscribeOrderBooksRequest) Reset()         { *m = SubscribeOrderBooksRequest{} }
+func (m *SubscribeOrderBooksRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeOrderBooksRequest) ProtoMessage()    {}
+func (*SubscribeOrderBooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61e07a5cb93f28c3, []int{0}
+}
+func (m *SubscribeOrderBooksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeOrderBooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeOrderBooksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeOrderBooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeOrderBooksRequest.Merge(m, src)
+}
+func (m *SubscribeOrderBooksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeOrderBooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeOrderBooksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeOrderBooksRequest proto.InternalMessageInfo
+
+// OrderBookUpdate is an update of a pair's order book and trades made in a
+// block.
+// An update of a pair is sent only if the order book has changed or there
+// were trades in the block, except for the first update which is always sent.
+type OrderBookUpdate struct {
+	Height int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	PairId uint64 `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// snapshot specifies whether order_book is a full snapshot of the order
+	// book, which is the case for the first update of each pair and updates
+	// where the price unit of the order book has changed.
+	// Otherwise, order_book is a diff from the previous update, which contains
+	// only ticks whose amounts have changed. Ticks removed from the order book
+	// have zero amounts.
+	Snapshot bool `protobuf:"varint,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// order_book specifies the order book with the smallest price unit.
+	// It is empty if the pair doesn't have the last price yet.
+	OrderBook OrderBookResponse `protobuf:"bytes,4,opt,name=order_book,json=orderBook,proto3" json:"order_book"`
+	// batch specifies the summary of the batch executed in the block, if any.
+	Batch           *EventBatchExecuted     `protobuf:"bytes,5,opt,name=batch,proto3" json:"batch,omitempty"`
+	UserOrderTrades []EventUserOrderMatched `protobuf:"bytes,6,rep,name=user_order_trades,json=userOrderTrades,proto3" json:"user_order_trades"`
+	PoolOrderTrades []EventPoolOrderMatched `protobuf:"bytes,7,rep,name=pool_order_trades,json=poolOrderTrades,proto3" json:"pool_order_trades"`
+}
+
+func (m *OrderBookUpdate) Reset()         { *m = OrderBookUpdate{} }
+func (m *OrderBookUpdate) String() string { return proto.CompactTextString(m) }
+func (*OrderBookUpdate) ProtoMessage()    {}
+func (*OrderBookUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61e07a5cb93f28c3, []int{1}
+}
+func (m *OrderBookUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookUpdate.Merge(m, src)
+}
+func (m *OrderBookUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookUpdate proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SubscribeOrderBooksRequest)(nil), "crescent.liquidity.v1beta1.SubscribeOrderBooksRequest")
+	proto.RegisterType((*OrderBookUpdate)(nil), "crescent.liquidity.v1beta1.OrderBookUpdate")
+}
+
+func init() {
+	proto.RegisterFile("crescent/liquidity/v1beta1/stream.proto", fileDescriptor_61e07a5cb93f28c3)
+}
+
+var fileDescriptor_61e07a5cb93f28c3 = []byte{
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0x33, 0x7f, 0xa7, 0x4e, 0x3a, 0xd5, 0x5f, 0x15, 0x03, 0x02, 0x63, 0x24, 0x63, 0x75,
+	0x01, 0x16, 0x08, 0x9b, 0x04, 0x89, 0x07, 0x88, 0xa8, 0x04, 0x0b, 0x04, 0x9a, 0xa6, 0x1b, 0x36,
+	0x91, 0x3f, 0xae, 0x6c, 0x2b, 0x89, 0xc7, 0x99, 0x3b, 0x53, 0x35, 0x12, 0x6b, 0xd6, 0x3c, 0x13,
+	0xab, 0x2c, 0xbb, 0x64, 0x85, 0x20, 0x79, 0x11, 0xe4, 0x8f, 0x44, 0x20, 0xb5, 0xa1, 0x3b, 0xdf,
+	0xf1, 0x99, 0xdf, 0x39, 0xba, 0x73, 0xe8, 0xd3, 0x58, 0x02, 0xc6, 0x50, 0xa8, 0x60, 0x96, 0x2f,
+	0x74, 0x9e, 0xe4, 0x6a, 0x19, 0x5c, 0x0c, 0x22, 0x50, 0xe1, 0x20, 0x40, 0x25, 0x21, 0x9c, 0xfb,
+	0xa5, 0x14, 0x4a, 0x30, 0x7b, 0x2b, 0xf4, 0x77, 0x42, 0xbf, 0x15, 0xda, 0xf7, 0x52, 0x91, 0x8a,
+	0x5a, 0x16, 0x54, 0x5f, 0xcd, 0x0d, 0x7b, 0x1f, 0x1a, 0x2e, 0xa0, 0x50, 0xd8, 0x0a, 0x9f, 0xec,
+	0x11, 0x2e, 0x34, 0xc8, 0x65, 0xa3, 0x3b, 0x19, 0x53, 0xfb, 0x4c, 0x47, 0x18, 0xcb, 0x3c, 0x82,
+	0x0f, 0x32, 0x01, 0x39, 0x12, 0x62, 0x8a, 0x1c, 0x16, 0x1a, 0x50, 0xb1, 0x87, 0xb4, 0x5f, 0x86,
+	0xb9, 0x9c, 0xe4, 0x09, 0x5a, 0xc4, 0x35, 0xbc, 0x2e, 0xef, 0x55, 0xf3, 0xbb, 0x04, 0xd9, 0x23,
+	0x7a, 0x58, 0xe8, 0xf9, 0x44, 0xe5, 0xf1, 0x14, 0xad, 0xff, 0x5c, 0xe2, 0xfd, 0xcf, 0xfb, 0x85,
+	0x9e, 0x8f, 0xab, 0xf9, 0xe4, 0x9b, 0x41, 0x8f, 0x77, 0xb4, 0xf3, 0x32, 0x09, 0x15, 0xb0, 0xfb,
+	0xd4, 0xcc, 0x20, 0x4f, 0x33, 0x65, 0x11, 0x97, 0x78, 0x06, 0x6f, 0x27, 0xf6, 0x80, 0xf6, 0x5a,
+	0x8f, 0x1a, 0xd3, 0xe5, 0x66, 0x63, 0xc1, 0x6c, 0xda, 0xc7, 0x22, 0x2c, 0x31, 0x13, 0xca, 0x32,
+	0x5c, 0xe2, 0xf5, 0xf9, 0x6e, 0x66, 0x9c, 0x52, 0x51, 0xf1, 0x27, 0x91, 0x10, 0x53, 0xab, 0xeb,
+	0x12, 0xef, 0x68, 0xf8, 0xc2, 0xbf, 0x79, 0x9d, 0xfe, 0x2e, 0x0d, 0x07, 0x2c, 0x45, 0x81, 0x30,
+	0xea, 0xae, 0x7e, 0x3c, 0xee, 0xf0, 0x43, 0xb1, 0xfd, 0xc1, 0xde, 0xd0, 0x83, 0x28, 0x54, 0x71,
+	0x66, 0x1d, 0xd4, 0x38, 0x7f, 0x1f, 0xee, 0xb4, 0xda, 0xf5, 0xa8, 0x52, 0x9f, 0x5e, 0x42, 0xac,
+	0x15, 0x24, 0xbc, 0xb9, 0xcc, 0x62, 0x7a, 0x47, 0x23, 0xc8, 0x49, 0x13, 0x4f, 0xc9, 0x30, 0x01,
+	0xb4, 0x4c, 0xd7, 0xf0, 0x8e, 0x86, 0x83, 0x7f, 0x12, 0xcf, 0x11, 0x64, 0x9d, 0xf4, 0x7d, 0xc5,
+	0x82, 0xa4, 0x0d, 0x79, 0xac, 0xb7, 0xe7, 0xe3, 0x9a, 0x57, 0x99, 0x94, 0x42, 0xcc, 0xfe, 0x36,
+	0xe9, 0xdd, 0xd2, 0xe4, 0xa3, 0x10, 0xb3, 0xeb, 0x4c, 0xca, 0xed, 0x79, 0x63, 0x32, 0xfc, 0x42,
+	0xa8, 0x79, 0x56, 0xd7, 0x95, 0x7d, 0xa6, 0x77, 0xaf, 0x69, 0x09, 0x7b, 0xbd, 0xcf, 0xeb, 0xe6,
+	0x5a, 0xd9, 0xcf, 0x6f, 0xf5, 0x52, 0x4d, 0x6f, 0x5e, 0x92, 0xd1, 0xdb, 0xd5, 0x2f, 0xa7, 0xb3,
+	0x5a, 0x3b, 0xe4, 0x6a, 0xed, 0x90, 0x9f, 0x6b, 0x87, 0x7c, 0xdd, 0x38, 0x9d, 0xab, 0x8d, 0xd3,
+	0xf9, 0xbe, 0x71, 0x3a, 0x9f, 0x9e, 0xa5, 0xb9, 0xca, 0x74, 0xe4, 0xc7, 0x62, 0x1e, 0x2c, 0x42,
+	0x0c, 0xb5, 0x0c, 0x30, 0x13, 0xa9, 0x2e, 0x82, 0xcb, 0x3f, 0xba, 0xaf, 0x96, 0x25, 0x60, 0x64,
+	0xd6, 0xa5, 0x7f, 0xf5, 0x7b, 0x00, 0xb1, 0x03, 0x9e, 0xfd, 0xa2, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamClient interface {
+	// SubscribeOrderBooks streams order books and trades of pairs, which are
+	// sent after each block is committed.
+	SubscribeOrderBooks(ctx context.Context, in *SubscribeOrderBooksRequest, opts ...grpc.CallOption) (Stream_SubscribeOrderBooksClient, error)
+}
+
+type streamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamClient(cc grpc1.ClientConn) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) SubscribeOrderBooks(ctx context.Context, in *SubscribeOrderBooksRequest, opts ...grpc.CallOption) (Stream_SubscribeOrderBooksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Stream_serviceDesc.Streams[0], "/crescent.liquidity.v1beta1.Stream/SubscribeOrderBooks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamSubscribeOrderBooksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stream_SubscribeOrderBooksClient interface {
+	Recv() (*OrderBookUpdate, error)
+	grpc.ClientStream
+}
+
+type streamSubscribeOrderBooksClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamSubscribeOrderBooksClient) Recv() (*OrderBookUpdate, error) {
+	m := new(OrderBookUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+type StreamServer interface {
+	// SubscribeOrderBooks streams order books and trades of pairs, which are
+	// sent after each block is committed.
+	SubscribeOrderBooks(*SubscribeOrderBooksRequest, Stream_SubscribeOrderBooksServer) error
+}
+
+// UnimplementedStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (*UnimplementedStreamServer) SubscribeOrderBooks(req *SubscribeOrderBooksRequest, srv Stream_SubscribeOrderBooksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOrderBooks not implemented")
+}
+
+func RegisterStreamServer(s grpc1.Server, srv StreamServer) {
+	s.RegisterService(&_Stream_serviceDesc, srv)
+}
+
+func _Stream_SubscribeOrderBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeOrderBooksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamServer).SubscribeOrderBooks(m, &streamSubscribeOrderBooksServer{stream})
+}
+
+type Stream_SubscribeOrderBooksServer interface {
+	Send(*OrderBookUpdate) error
+	grpc.ServerStream
+}
+
+type streamSubscribeOrderBooksServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamSubscribeOrderBooksServer) Send(m *OrderBookUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Stream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "crescent.liquidity.v1beta1.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeOrderBooks",
+			Handler:       _Stream_SubscribeOrderBooks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "crescent/liquidity/v1beta1/stream.proto",
+}
+
+func (m *SubscribeOrderBooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeOrderBooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeOrderBooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumTicks != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.NumTicks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PairIds) > 0 {
+		dAtA2 := make([]byte, len(m.PairIds)*10)
+		var j1 int
+		for _, num := range m.PairIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintStream(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolOrderTrades) > 0 {
+		for iNdEx := len(m.PoolOrderTrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolOrderTrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.UserOrderTrades) > 0 {
+		for iNdEx := len(m.UserOrderTrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserOrderTrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.OrderBook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PairId != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeOrderBooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PairIds) > 0 {
+		l = 0
+		for _, e := range m.PairIds {
+			l += sovStream(uint64(e))
+		}
+		n += 1 + sovStream(uint64(l)) + l
+	}
+	if m.NumTicks != 0 {
+		n += 1 + sovStream(uint64(m.NumTicks))
+	}
+	return n
+}
+
+func (m *OrderBookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStream(uint64(m.Height))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovStream(uint64(m.PairId))
+	}
+	if m.Snapshot {
+		n += 2
+	}
+	l = m.OrderBook.Size()
+	n += 1 + l + sovStream(uint64(l))
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if len(m.UserOrderTrades) > 0 {
+		for _, e := range m.UserOrderTrades {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if len(m.PoolOrderTrades) > 0 {
+		for _, e := range m.PoolOrderTrades {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStream(x uint64) (n int) {
+	return sovStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeOrderBooksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeOrderBooksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeOrderBooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStream
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PairIds = append(m.PairIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStream
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStream
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStream
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PairIds) == 0 {
+					m.PairIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStream
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PairIds = append(m.PairIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIds", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTicks", wireType)
+			}
+			m.NumTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTicks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Snapshot = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderBook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &EventBatchExecuted{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOrderTrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserOrderTrades = append(m.UserOrderTrades, EventUserOrderMatched{})
+			if err := m.UserOrderTrades[len(m.UserOrderTrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolOrderTrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolOrderTrades = append(m.PoolOrderTrades, EventPoolOrderMatched{})
+			if err := m.PoolOrderTrades[len(m.PoolOrderTrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStream = fmt.Errorf("proto: unexpected end of group")
+)